@@ -0,0 +1,298 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrClientClosed = errors.New("client closed")
+
+// AsyncOptions configures the background poller behind SendAsync.
+type AsyncOptions struct {
+	PollInterval  time.Duration // Defaults to 500 milliseconds
+	MaxPollErrors int           // Consecutive failed status checks after which a future fails. Defaults to 3
+}
+
+// WithAsync configures the background poller of the client, see SendAsync.
+func WithAsync(options AsyncOptions) ClientOption {
+	return func(n *NovitusClient) {
+		n.async = newAsyncPoller(n, options)
+	}
+}
+
+// Future is the handle of a document sent with SendAsync. Done is closed
+// once the final status or an error is known.
+type Future struct {
+	documentType string
+	confirm      bool
+	done         chan struct{}
+
+	mu         sync.Mutex
+	requestId  string
+	status     CheckDocumentStatusResponse
+	err        error
+	pollErrors int
+}
+
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// RequestId returns the id of the request, empty until the document is
+// stored on the printer.
+func (f *Future) RequestId() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requestId
+}
+
+// Status returns the latest known status of the document.
+func (f *Future) Status() CheckDocumentStatusResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status
+}
+
+// Result waits for Done and returns the final status. A document the
+// printer failed to print has status ERROR and no error, like with
+// WaitForDocument.
+func (f *Future) Result() (CheckDocumentStatusResponse, error) {
+	<-f.done
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status, f.err
+}
+
+func (f *Future) update(status CheckDocumentStatusResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+	f.pollErrors = 0
+}
+
+func (f *Future) complete(status CheckDocumentStatusResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	select {
+	case <-f.done:
+		return
+	default:
+	}
+	f.status = status
+	f.err = err
+	close(f.done)
+}
+
+// SendAsync sends the document in the background and returns at once.
+// With confirm set, the client's background poller checks the status
+// until the document is DONE or ERROR; without it, the future is done as
+// soon as the document is stored.
+func (n *NovitusClient) SendAsync(documentType string, document Document, confirm bool) *Future {
+	f := &Future{documentType: documentType, confirm: confirm, done: make(chan struct{})}
+	if !n.async.start() {
+		f.complete(CheckDocumentStatusResponse{}, ErrClientClosed)
+		return f
+	}
+	go func() {
+		defer n.async.wg.Done()
+		n.async.send(f, document)
+	}()
+	return f
+}
+
+func (n *NovitusClient) SendReceiptAsync(receipt *Receipt, confirm bool) *Future {
+	return n.SendAsync("receipt", receipt, confirm)
+}
+
+func (n *NovitusClient) SendInvoiceAsync(invoice *Invoice, confirm bool) *Future {
+	return n.SendAsync("invoice", invoice, confirm)
+}
+
+func (n *NovitusClient) SendNFPrintoutAsync(printout *Printout, confirm bool) *Future {
+	return n.SendAsync("nf_printout", printout, confirm)
+}
+
+// Close stops the background poller and ends all event subscriptions.
+// Futures that are not done yet fail with ErrClientClosed; their documents
+// may still be printed.
+func (n *NovitusClient) Close() {
+	n.async.close()
+	n.events.close()
+}
+
+// closed reports whether Close was called.
+func (n *NovitusClient) closed() bool {
+	n.async.mu.Lock()
+	defer n.async.mu.Unlock()
+	return n.async.closed
+}
+
+type asyncPoller struct {
+	client  *NovitusClient
+	options AsyncOptions
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	mu      sync.Mutex
+	futures map[*Future]struct{}
+	running bool
+	closed  bool
+}
+
+func newAsyncPoller(client *NovitusClient, options AsyncOptions) *asyncPoller {
+	if options.PollInterval <= 0 {
+		options.PollInterval = 500 * time.Millisecond
+	}
+	if options.MaxPollErrors <= 0 {
+		options.MaxPollErrors = 3
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &asyncPoller{
+		client:  client,
+		options: options,
+		ctx:     ctx,
+		cancel:  cancel,
+		futures: make(map[*Future]struct{}),
+	}
+}
+
+// start registers a sending goroutine, it fails once the poller is closed.
+func (a *asyncPoller) start() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.closed {
+		return false
+	}
+	a.wg.Add(1)
+	return true
+}
+
+func (a *asyncPoller) send(f *Future, document Document) {
+	n := a.client
+	sendDocumentResponse, err := n.SendDocumentContext(a.ctx, f.documentType, document)
+	if err != nil {
+		if a.ctx.Err() != nil {
+			err = ErrClientClosed
+		}
+		f.complete(CheckDocumentStatusResponse{}, fmt.Errorf("failed to send %s: %w", f.documentType, err))
+		return
+	}
+	requestId := sendDocumentResponse.Request.Id
+	f.mu.Lock()
+	f.requestId = requestId
+	f.status.Request = sendDocumentResponse.Request
+	f.mu.Unlock()
+	if !f.confirm {
+		status, err := n.CheckDocumentStatus(f.documentType, requestId)
+		if err != nil {
+			f.complete(f.Status(), err)
+			return
+		}
+		f.complete(status, nil)
+		return
+	}
+	confirmResponse, err := n.Confirm(f.documentType, requestId)
+	if err != nil {
+		f.complete(f.Status(), fmt.Errorf("failed to confirm document: %w", err))
+		return
+	}
+	f.mu.Lock()
+	f.status.Request = confirmResponse.Request
+	f.mu.Unlock()
+	a.watch(f)
+}
+
+// watch hands the future to the poller, starting it if it is not running.
+func (a *asyncPoller) watch(f *Future) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.closed {
+		f.complete(f.Status(), ErrClientClosed)
+		return
+	}
+	a.futures[f] = struct{}{}
+	if !a.running {
+		a.running = true
+		a.wg.Add(1)
+		go a.run()
+	}
+}
+
+// run polls the watched futures until there are none left, so an idle
+// client keeps no goroutine running.
+func (a *asyncPoller) run() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(a.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		a.mu.Lock()
+		if len(a.futures) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		futures := make([]*Future, 0, len(a.futures))
+		for f := range a.futures {
+			futures = append(futures, f)
+		}
+		a.mu.Unlock()
+		for _, f := range futures {
+			if a.ctx.Err() != nil {
+				return
+			}
+			a.poll(f)
+		}
+	}
+}
+
+func (a *asyncPoller) poll(f *Future) {
+	requestId := f.RequestId()
+	status, err := a.client.CheckDocumentStatus(f.documentType, requestId)
+	if err != nil {
+		f.mu.Lock()
+		f.pollErrors++
+		failed := f.pollErrors >= a.options.MaxPollErrors
+		f.mu.Unlock()
+		if failed {
+			a.finish(f, f.Status(), fmt.Errorf("failed to check status of document %s: %w", requestId, err))
+		}
+		return
+	}
+	f.update(status)
+	if status.IsFinished() {
+		a.finish(f, status, nil)
+	}
+}
+
+func (a *asyncPoller) finish(f *Future, status CheckDocumentStatusResponse, err error) {
+	a.mu.Lock()
+	delete(a.futures, f)
+	a.mu.Unlock()
+	f.complete(status, err)
+}
+
+func (a *asyncPoller) close() {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return
+	}
+	a.closed = true
+	a.cancel()
+	futures := a.futures
+	a.futures = make(map[*Future]struct{})
+	a.mu.Unlock()
+	for f := range futures {
+		f.complete(f.Status(), ErrClientClosed)
+	}
+	a.wg.Wait()
+}
@@ -0,0 +1,129 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newAsyncClient(t *testing.T) (*NovitusClient, *fakeNovitus) {
+	client, f := newFakeClient(t, WithAsync(AsyncOptions{PollInterval: 10 * time.Millisecond, MaxPollErrors: 2}))
+	t.Cleanup(client.Close)
+	return client, f
+}
+
+// pendingStatus answers every status check with PENDING.
+func pendingStatus(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet || r.URL.Path == "/api/v1/token" || r.URL.Path == "/api/v1/queue" {
+		return false
+	}
+	fmt.Fprint(w, `{"request":{"id":"req1","status":"PENDING"}}`)
+	return true
+}
+
+func TestSendAsync(t *testing.T) {
+	client, _ := newAsyncClient(t)
+	future := client.SendReceiptAsync(sampleReceipt(), true)
+	status, err := future.Result()
+	if err != nil || status.Request.Status != RequestStatusDone || future.RequestId() != "req1" {
+		t.Fatalf("Result() = %s, %v, request %q", status.Request.Status, err, future.RequestId())
+	}
+	// The poller stops on its next tick without futures left.
+	time.Sleep(50 * time.Millisecond)
+	client.async.mu.Lock()
+	running, watched := client.async.running, len(client.async.futures)
+	client.async.mu.Unlock()
+	if watched != 0 {
+		t.Errorf("%d futures still watched", watched)
+	}
+	if running {
+		t.Error("poller still running without futures")
+	}
+}
+
+func TestSendAsyncUnconfirmed(t *testing.T) {
+	client, f := newAsyncClient(t)
+	status, err := client.SendNFPrintoutAsync(&Printout{Lines: PrintoutLines{&TextLine{Text: "a"}}}, false).Result()
+	if err != nil || status.Request.Status != RequestStatusStored {
+		t.Fatalf("Result() = %s, %v", status.Request.Status, err)
+	}
+	if got := f.count("PUT /api/v1/nf_printout/req1"); got != 0 {
+		t.Errorf("confirmed %d times", got)
+	}
+}
+
+func TestSendAsyncErrors(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		client, f := newAsyncClient(t)
+		_, err := client.SendReceiptAsync(&Receipt{}, true).Result()
+		if !errors.Is(err, ErrValidation) {
+			t.Errorf("err = %v, want ErrValidation", err)
+		}
+		if got := f.count("POST /api/v1/receipt"); got != 0 {
+			t.Errorf("sent %d invalid documents", got)
+		}
+	})
+	t.Run("status checks fail", func(t *testing.T) {
+		client, f := newAsyncClient(t)
+		f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+			if r.Method != http.MethodGet || r.URL.Path == "/api/v1/token" || r.URL.Path == "/api/v1/queue" {
+				return false
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"exception":{"code":1,"description":"boom"}}`)
+			return true
+		})
+		future := client.SendReceiptAsync(sampleReceipt(), true)
+		status, err := future.Result()
+		if err == nil || status.Request.Status != RequestStatusConfirmed {
+			t.Fatalf("Result() = %s, %v", status.Request.Status, err)
+		}
+		if got := f.count("GET /api/v1/receipt/req1"); got != 2 {
+			t.Errorf("status checked %d times, want MaxPollErrors", got)
+		}
+	})
+}
+
+func TestSendAsyncStatus(t *testing.T) {
+	client, f := newAsyncClient(t)
+	f.setHandle(pendingStatus)
+	future := client.SendReceiptAsync(sampleReceipt(), true)
+	deadline := time.Now().Add(time.Second)
+	for future.Status().Request.Status != RequestStatusPending {
+		if time.Now().After(deadline) {
+			t.Fatalf("status = %s, want PENDING", future.Status().Request.Status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	select {
+	case <-future.Done():
+		t.Fatal("future done before a final status")
+	default:
+	}
+	f.setHandle(nil)
+	f.mu.Lock()
+	f.statuses["req1"] = RequestStatusDone
+	f.mu.Unlock()
+	if status, err := future.Result(); err != nil || status.Request.Status != RequestStatusDone {
+		t.Errorf("Result() = %s, %v", status.Request.Status, err)
+	}
+}
+
+func TestCloseAsync(t *testing.T) {
+	client, f := newAsyncClient(t)
+	f.setHandle(pendingStatus)
+	future := client.SendReceiptAsync(sampleReceipt(), true)
+	for future.RequestId() == "" || future.Status().Request.Status == RequestStatusStored {
+		time.Sleep(5 * time.Millisecond)
+	}
+	client.Close()
+	if _, err := future.Result(); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("pending future: err = %v, want ErrClientClosed", err)
+	}
+	if _, err := client.SendReceiptAsync(sampleReceipt(), true).Result(); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("send after Close: err = %v, want ErrClientClosed", err)
+	}
+	client.Close()
+}
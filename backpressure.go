@@ -0,0 +1,93 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type BackpressureMode string
+
+const (
+	BackpressureBlock  BackpressureMode = "block"  // Wait until the queue drops below MaxQueue, for at most MaxWait or until the context is done
+	BackpressureReject BackpressureMode = "reject" // Fail with *QueueFullError
+	BackpressureDelay  BackpressureMode = "delay"  // Wait Delay once, then send anyway
+)
+
+// Backpressure limits how many requests are piled up on the printer.
+// Before a document is sent, the queue depth is read from GetQueueStatus,
+// or from the cached value if it is younger than CacheFor, and Mode
+// decides what happens once it reaches MaxQueue.
+type Backpressure struct {
+	Mode     BackpressureMode // Enum: "block" "reject" "delay". Defaults to "block"
+	MaxQueue int              // Queue depth at which backpressure starts. Defaults to 10
+	CacheFor time.Duration    // How long a queue depth is reused. Defaults to 1 second
+	Delay    time.Duration    // Poll interval in block mode, wait in delay mode. Defaults to 500 milliseconds
+	MaxWait  time.Duration    // Longest wait in block mode before failing with *QueueFullError. Defaults to 30 seconds
+}
+
+// QueueFullError is returned when the printer queue is at or above the
+// backpressure limit.
+type QueueFullError struct {
+	RequestsInQueue int
+	MaxQueue        int
+}
+
+func (e *QueueFullError) Error() string {
+	return fmt.Sprintf("printer queue is full: %d requests, limit %d", e.RequestsInQueue, e.MaxQueue)
+}
+
+// QueueDepth returns the queue depth known to the client: the last value
+// from GetQueueStatus plus the documents sent since. ok is false if the
+// queue was never checked.
+func (n *NovitusClient) QueueDepth() (depth int, checkedAt time.Time, ok bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.queueDepth, n.queueCheckedAt, !n.queueCheckedAt.IsZero()
+}
+
+func (n *NovitusClient) currentQueueDepth(maxAge time.Duration) (int, error) {
+	if depth, checkedAt, ok := n.QueueDepth(); ok && time.Since(checkedAt) < maxAge {
+		return depth, nil
+	}
+	queue, err := n.GetQueueStatus()
+	if err != nil {
+		return 0, err
+	}
+	return queue.RequestsInQueue, nil
+}
+
+// waitForQueue applies the backpressure mode before a send.
+func (n *NovitusClient) waitForQueue(ctx context.Context) error {
+	b := n.backpressure
+	if b == nil {
+		return nil
+	}
+	maxAge := b.CacheFor
+	deadline := time.Now().Add(b.MaxWait)
+	for {
+		depth, err := n.currentQueueDepth(maxAge)
+		if err != nil {
+			return fmt.Errorf("failed to check queue before sending document: %w", err)
+		}
+		if depth < b.MaxQueue {
+			return nil
+		}
+		wait := b.Delay
+		if b.Mode == BackpressureBlock {
+			wait = min(wait, time.Until(deadline))
+		}
+		if b.Mode == BackpressureReject || wait <= 0 {
+			return &QueueFullError{RequestsInQueue: depth, MaxQueue: b.MaxQueue}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for printer queue: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+		if b.Mode == BackpressureDelay {
+			return nil
+		}
+		maxAge = 0
+	}
+}
@@ -0,0 +1,94 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithBackpressureDefaults(t *testing.T) {
+	client := &NovitusClient{}
+	WithBackpressure(Backpressure{MaxQueue: -1})(client)
+	want := Backpressure{Mode: BackpressureBlock, MaxQueue: 10, CacheFor: time.Second, Delay: 500 * time.Millisecond, MaxWait: 30 * time.Second}
+	if *client.backpressure != want {
+		t.Errorf("got %+v, want %+v", *client.backpressure, want)
+	}
+}
+
+func TestBackpressure(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      BackpressureMode
+		queue     int
+		drainAt   int // Number of queue checks after which the queue is empty, 0 never
+		timeout   time.Duration
+		wantErr   error
+		wantSends int
+	}{
+		{"below the limit", BackpressureReject, 4, 0, 0, nil, 1},
+		{"reject at the limit", BackpressureReject, 5, 0, 0, &QueueFullError{}, 0},
+		{"block until drained", BackpressureBlock, 5, 3, 0, nil, 1},
+		{"block up to MaxWait", BackpressureBlock, 5, 0, 0, &QueueFullError{}, 0},
+		{"block until the context is done", BackpressureBlock, 5, 0, 5 * time.Millisecond, context.DeadlineExceeded, 0},
+		{"delay sends anyway", BackpressureDelay, 5, 0, 0, nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, f := newFakeClient(t, WithBackpressure(Backpressure{Mode: tt.mode, MaxQueue: 5, CacheFor: time.Nanosecond, Delay: time.Millisecond, MaxWait: 50 * time.Millisecond}))
+			f.queue = tt.queue
+			if tt.drainAt > 0 {
+				checks := 0
+				f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+					if r.URL.Path == "/api/v1/queue" {
+						f.mu.Lock()
+						if checks++; checks >= tt.drainAt {
+							f.queue = 0
+						}
+						f.mu.Unlock()
+					}
+					return false
+				})
+			}
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			_, err := client.SendDocumentContext(ctx, "receipt", sampleReceipt())
+			var queueFull *QueueFullError
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error %v", err)
+			case errors.As(tt.wantErr, &queueFull) && !errors.As(err, &queueFull):
+				t.Errorf("got %v, want *QueueFullError", err)
+			case errors.Is(tt.wantErr, context.DeadlineExceeded) && !errors.Is(err, context.DeadlineExceeded):
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+			if got := f.count("POST /api/v1/receipt"); got != tt.wantSends {
+				t.Errorf("sent %d documents, want %d", got, tt.wantSends)
+			}
+		})
+	}
+}
+
+func TestBackpressureCache(t *testing.T) {
+	client, f := newFakeClient(t, WithBackpressure(Backpressure{Mode: BackpressureReject, MaxQueue: 2, CacheFor: time.Hour}))
+	for range 2 {
+		if _, err := client.SendDocument("receipt", sampleReceipt()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var queueFull *QueueFullError
+	if _, err := client.SendDocument("receipt", sampleReceipt()); !errors.As(err, &queueFull) || queueFull.RequestsInQueue != 2 {
+		t.Errorf("third send = %v, want a full queue counted from the cache", err)
+	}
+	if got := f.count("GET /api/v1/queue"); got != 1 {
+		t.Errorf("queue checked %d times, want 1", got)
+	}
+	if depth, _, ok := client.QueueDepth(); !ok || depth != 2 {
+		t.Errorf("QueueDepth() = %d, %v", depth, ok)
+	}
+}
@@ -0,0 +1,86 @@
+package novitus_gosdk
+
+import "fmt"
+
+type BarcodeType string
+
+const (
+	BarcodeEAN8    BarcodeType = "ean8"
+	BarcodeEAN13   BarcodeType = "ean13"
+	BarcodeCode128 BarcodeType = "code128"
+)
+
+// HRIPosition is the placement of the human readable interpretation of
+// a barcode.
+type HRIPosition string
+
+const (
+	HRINone  HRIPosition = "none"
+	HRIAbove HRIPosition = "above"
+	HRIBelow HRIPosition = "below"
+	HRIBoth  HRIPosition = "both"
+)
+
+type QRErrorCorrection string
+
+const (
+	QRLevelL QRErrorCorrection = "L"
+	QRLevelM QRErrorCorrection = "M"
+	QRLevelQ QRErrorCorrection = "Q"
+	QRLevelH QRErrorCorrection = "H"
+)
+
+// qrCapacity is the byte mode capacity of the largest QR code version for
+// each error correction level.
+var qrCapacity = map[QRErrorCorrection]int{
+	QRLevelL: 2953,
+	QRLevelM: 2331,
+	QRLevelQ: 1663,
+	QRLevelH: 1273,
+}
+
+func (t BarcodeType) validateCode(code string) error {
+	switch t {
+	case BarcodeEAN8:
+		return validateEAN(code, 8)
+	case BarcodeEAN13:
+		return validateEAN(code, 13)
+	case BarcodeCode128:
+		for _, r := range code {
+			if r < 32 || r > 126 {
+				return fmt.Errorf("code may contain only printable ASCII characters, got %q", r)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("type must be one of: ean8, ean13, code128")
+}
+
+// validateEAN accepts an EAN code with or without its check digit; when
+// the check digit is present it has to be correct.
+func validateEAN(code string, length int) error {
+	if len(code) != length && len(code) != length-1 {
+		return fmt.Errorf("code must have %d or %d digits", length-1, length)
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("code may contain only digits")
+		}
+	}
+	if len(code) == length && eanCheckDigit(code[:length-1]) != code[length-1] {
+		return fmt.Errorf("invalid check digit")
+	}
+	return nil
+}
+
+func eanCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,61 @@
+package novitus_gosdk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBarcodeValidate(t *testing.T) {
+	tests := []struct {
+		barcode Barcode
+		err     string
+	}{
+		{Barcode{Type: BarcodeEAN13, Code: "5901234123457"}, ""},
+		{Barcode{Type: BarcodeEAN13, Code: "590123412345"}, ""},
+		{Barcode{Type: BarcodeEAN8, Code: "96385074"}, ""},
+		{Barcode{Type: BarcodeEAN13, Code: "5901234123458"}, "invalid check digit"},
+		{Barcode{Type: BarcodeEAN13, Code: "59012341234"}, "must have 12 or 13 digits"},
+		{Barcode{Type: BarcodeEAN8, Code: "9638507A"}, "only digits"},
+		{Barcode{Type: BarcodeCode128, Code: strings.Repeat("A", 80)}, ""},
+		{Barcode{Type: BarcodeCode128, Code: "zażółć"}, "printable ASCII"},
+		{Barcode{Type: "qr", Code: "1"}, "type must be one of"},
+		{Barcode{Type: BarcodeCode128}, "code is required"},
+		{Barcode{Type: BarcodeCode128, Code: "A", Height: 256}, "height must be between 1 and 255, or 0"},
+		{Barcode{Type: BarcodeCode128, Code: "A", Width: 7}, "width must be between 1 and 6, or 0"},
+		{Barcode{Type: BarcodeCode128, Code: "A", HRI: "left"}, "hri must be one of"},
+		{Barcode{Type: BarcodeCode128, Code: "A", Height: 80, Width: 2, HRI: HRIBelow}, ""},
+	}
+	for _, tt := range tests {
+		err := tt.barcode.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%+v: unexpected error %v", tt.barcode, err)
+		}
+		if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%+v: got %v, want %q", tt.barcode, err, tt.err)
+		}
+	}
+}
+
+func TestQRCodeValidate(t *testing.T) {
+	tests := []struct {
+		qr  QRCode
+		err string
+	}{
+		{QRCode{Code: "https://example.com"}, ""},
+		{QRCode{Code: "a", Size: 16, ErrorCorrection: QRLevelH}, ""},
+		{QRCode{}, "code is required"},
+		{QRCode{Code: "a", Size: 17}, "size must be between 1 and 16, or 0"},
+		{QRCode{Code: "a", ErrorCorrection: "X"}, "error_correction must be one of"},
+		{QRCode{Code: strings.Repeat("a", 2332)}, "at most 2331 bytes"},
+		{QRCode{Code: strings.Repeat("a", 2332), ErrorCorrection: QRLevelL}, ""},
+	}
+	for _, tt := range tests {
+		err := tt.qr.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%+v: unexpected error %v", tt.qr.ErrorCorrection, err)
+		}
+		if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%d bytes %s: got %v, want %q", len(tt.qr.Code), tt.qr.ErrorCorrection, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrNotSent = errors.New("document not sent")
+
+// BatchDocument is one document of a batch. Key selects the printer when
+// the batch goes through a Pool and is ignored by NovitusClient.SendBatch.
+type BatchDocument struct {
+	Key      string
+	Type     string // Object type, e.g. "nf_printout" or "invoice" Required: true
+	Document Document
+	Confirm  bool
+}
+
+type BatchResult struct {
+	Index   int    // Position of the document in the batch
+	Printer string // Name of the pool client that handled the document
+	Status  CheckDocumentStatusResponse
+	Err     error
+}
+
+// BatchReport holds one result per document, in the order of the batch.
+type BatchReport struct {
+	Results   []BatchResult
+	Succeeded int
+	Failed    int
+}
+
+// Err joins the errors of all failed documents, nil if all succeeded.
+func (r BatchReport) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("document %d: %w", result.Index, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *BatchReport) count() {
+	r.Succeeded, r.Failed = 0, 0
+	for _, result := range r.Results {
+		if result.Err != nil {
+			r.Failed++
+		} else {
+			r.Succeeded++
+		}
+	}
+}
+
+// validateBatch validates every document before anything is sent. If any
+// document is invalid, the report holds the validation errors and every
+// other document fails with ErrNotSent.
+func validateBatch(docs []BatchDocument) (BatchReport, error) {
+	report := BatchReport{Results: make([]BatchResult, len(docs))}
+	invalid := false
+	for idx, doc := range docs {
+		report.Results[idx].Index = idx
+		var err error
+		switch {
+		case doc.Type == "":
+			err = fmt.Errorf("type is required")
+		case doc.Document == nil:
+			err = fmt.Errorf("document is required")
+		default:
+			err = doc.Document.Validate()
+		}
+		if err != nil {
+			report.Results[idx].Err = fmt.Errorf("%w: %w", ErrValidation, err)
+			invalid = true
+		}
+	}
+	if !invalid {
+		return report, nil
+	}
+	err := fmt.Errorf("batch not sent: %w", report.Err())
+	for idx := range report.Results {
+		if report.Results[idx].Err == nil {
+			report.Results[idx].Err = ErrNotSent
+		}
+	}
+	report.count()
+	return report, err
+}
+
+// SendBatch validates all documents, then sends them one by one in order,
+// confirming those with Confirm set. Documents left when ctx is done fail
+// with ErrNotSent. The error is non-nil only if the batch was not sent
+// because of invalid documents; per-document errors are in the report.
+func (n *NovitusClient) SendBatch(ctx context.Context, docs []BatchDocument) (BatchReport, error) {
+	report, err := validateBatch(docs)
+	if err != nil {
+		return report, err
+	}
+	for idx, doc := range docs {
+		if err := ctx.Err(); err != nil {
+			report.Results[idx].Err = fmt.Errorf("%w: %w", ErrNotSent, err)
+			continue
+		}
+		report.Results[idx].Status, report.Results[idx].Err = n.sendBatchDocument(ctx, doc)
+	}
+	report.count()
+	return report, nil
+}
+
+func (n *NovitusClient) sendBatchDocument(ctx context.Context, doc BatchDocument) (CheckDocumentStatusResponse, error) {
+	sendDocumentResponse, err := n.sendValidated(ctx, doc.Type, doc.Document)
+	if err != nil {
+		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to send %s: %w", doc.Type, err)
+	}
+	requestId := sendDocumentResponse.Request.Id
+	if doc.Confirm {
+		if _, err := n.Confirm(doc.Type, requestId); err != nil {
+			return CheckDocumentStatusResponse{Request: Request{Id: requestId}}, fmt.Errorf("failed to confirm document: %w", err)
+		}
+	}
+	return n.CheckDocumentStatus(doc.Type, requestId)
+}
+
+// SetBatchConcurrency sets how many printers SendBatch sends to at the
+// same time. Defaults to 4.
+func (p *Pool) SetBatchConcurrency(printers int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.batchConcurrency = printers
+}
+
+// SendBatch validates all documents, then sends each to the printer routed
+// for its key like SendContext, but without failover: documents routed to
+// the same printer are sent one by one in the order of the batch and are
+// all printed on that printer, or fail with ErrNoHealthyPrinter while it is
+// unhealthy. Up to the batch concurrency printers are used at the same
+// time. Documents left when ctx is done fail with ErrNotSent. The error is
+// non-nil only if the batch was not sent because of invalid documents;
+// per-document errors are in the report.
+func (p *Pool) SendBatch(ctx context.Context, docs []BatchDocument) (BatchReport, error) {
+	report, err := validateBatch(docs)
+	if err != nil {
+		return report, err
+	}
+	var order []string
+	groups := make(map[string][]int)
+	for idx, doc := range docs {
+		group, _, err := p.Resolve(doc.Key)
+		if err != nil {
+			group = doc.Key
+		}
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], idx)
+	}
+
+	p.mu.RLock()
+	workers := p.batchConcurrency
+	p.mu.RUnlock()
+	if workers <= 0 {
+		workers = 4
+	}
+	queue := make(chan []int, len(order))
+	for _, group := range order {
+		queue <- groups[group]
+	}
+	close(queue)
+	var wg sync.WaitGroup
+	for range min(workers, len(order)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for indexes := range queue {
+				for _, idx := range indexes {
+					result := &report.Results[idx]
+					if err := ctx.Err(); err != nil {
+						result.Err = fmt.Errorf("%w: %w", ErrNotSent, err)
+						continue
+					}
+					doc := docs[idx]
+					poolResult, err := p.sendRouted(ctx, doc)
+					result.Printer, result.Status, result.Err = poolResult.Printer, poolResult.Status, err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	report.count()
+	return report, nil
+}
+
+// sendRouted sends a validated batch document to its routed printer only.
+func (p *Pool) sendRouted(ctx context.Context, doc BatchDocument) (PoolResult, error) {
+	name, _, err := p.Resolve(doc.Key)
+	if err != nil {
+		return PoolResult{}, err
+	}
+	if status, ok := p.Status(name); ok && !status.Healthy && p.failoverEnabled() {
+		return PoolResult{}, fmt.Errorf("%w: printer %s is unhealthy and batches do not fail over", ErrNoHealthyPrinter, name)
+	}
+	return p.sendCandidates(ctx, doc.Key, []string{name}, doc.Type, doc.Document, doc.Confirm)
+}
@@ -0,0 +1,142 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+// countingPrintout counts how often it is validated.
+type countingPrintout struct {
+	*Printout
+	validations *atomic.Int32
+}
+
+func (c countingPrintout) Validate() error {
+	c.validations.Add(1)
+	return c.Printout.Validate()
+}
+
+func printoutDoc(key, text string, validations *atomic.Int32) BatchDocument {
+	return BatchDocument{Key: key, Type: "nf_printout", Document: countingPrintout{&Printout{Lines: PrintoutLines{&TextLine{Text: text}}}, validations}}
+}
+
+func TestSendBatch(t *testing.T) {
+	client, f := newFakeClient(t)
+	var validations atomic.Int32
+	docs := []BatchDocument{printoutDoc("", "a", &validations), printoutDoc("", "b", &validations)}
+	docs[1].Confirm = true
+	report, err := client.SendBatch(t.Context(), docs)
+	if err != nil || report.Succeeded != 2 || report.Err() != nil {
+		t.Fatalf("SendBatch() = %+v, %v", report, err)
+	}
+	if report.Results[0].Status.Request.Status != RequestStatusStored || report.Results[1].Status.Request.Status != RequestStatusConfirmed {
+		t.Errorf("statuses = %s, %s", report.Results[0].Status.Request.Status, report.Results[1].Status.Request.Status)
+	}
+	if got := validations.Load(); got != 2 {
+		t.Errorf("documents validated %d times, want once each", got)
+	}
+	if got := f.count("POST /api/v1/nf_printout"); got != 2 {
+		t.Errorf("sent %d documents", got)
+	}
+}
+
+func TestSendBatchInvalid(t *testing.T) {
+	client, f := newFakeClient(t)
+	var validations atomic.Int32
+	docs := []BatchDocument{printoutDoc("", "a", &validations), {Type: "nf_printout", Document: &Printout{}}, {Document: &Printout{}}}
+	report, err := client.SendBatch(t.Context(), docs)
+	if err == nil || report.Failed != 3 {
+		t.Fatalf("SendBatch() = %+v, %v", report, err)
+	}
+	if !errors.Is(report.Results[0].Err, ErrNotSent) || !errors.Is(report.Results[1].Err, ErrValidation) || !errors.Is(report.Results[2].Err, ErrValidation) {
+		t.Errorf("results = %+v", report.Results)
+	}
+	if got := f.count("POST /api/v1/nf_printout"); got != 0 {
+		t.Errorf("sent %d documents of an invalid batch", got)
+	}
+}
+
+func TestSendBatchCanceled(t *testing.T) {
+	client, f := newFakeClient(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	report, err := client.SendBatch(ctx, []BatchDocument{printoutDoc("", "a", new(atomic.Int32))})
+	if err != nil || !errors.Is(report.Results[0].Err, ErrNotSent) || !errors.Is(report.Results[0].Err, context.Canceled) {
+		t.Fatalf("SendBatch() = %+v, %v", report, err)
+	}
+	if got := f.count("POST /api/v1/nf_printout"); got != 0 {
+		t.Errorf("sent %d documents after cancel", got)
+	}
+}
+
+func TestPoolSendBatch(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1", "p2")
+	pool.Route("register-1", "p1")
+	pool.Route("register-2", "p2")
+	var validations atomic.Int32
+	docs := []BatchDocument{
+		printoutDoc("register-1", "a", &validations),
+		printoutDoc("register-2", "b", &validations),
+		printoutDoc("p1", "c", &validations),
+		printoutDoc("register-3", "d", &validations),
+	}
+	report, err := pool.SendBatch(t.Context(), docs)
+	if err != nil || report.Succeeded != 3 || report.Failed != 1 {
+		t.Fatalf("SendBatch() = %+v, %v", report, err)
+	}
+	for idx, want := range []string{"p1", "p2", "p1"} {
+		if report.Results[idx].Printer != want {
+			t.Errorf("document %d printed on %q, want %q", idx, report.Results[idx].Printer, want)
+		}
+	}
+	// The route key and the printer name group on the same printer, in
+	// order.
+	if report.Results[0].Status.Request.Id != "req1" || report.Results[2].Status.Request.Id != "req2" {
+		t.Errorf("requests on p1 = %s, %s", report.Results[0].Status.Request.Id, report.Results[2].Status.Request.Id)
+	}
+	if !errors.Is(report.Results[3].Err, ErrNoRoute) {
+		t.Errorf("unrouted document: %v", report.Results[3].Err)
+	}
+	if got := validations.Load(); got != 4 {
+		t.Errorf("documents validated %d times, want once each", got)
+	}
+	if got := fakes["p1"].count("POST /api/v1/nf_printout"); got != 2 {
+		t.Errorf("p1 received %d documents", got)
+	}
+}
+
+func TestPoolSendBatchNoFailover(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1", "p2")
+	pool.SetFailover(FailoverPolicy{})
+	pool.MarkUnhealthy("p1", errors.New("paper jam"))
+	report, err := pool.SendBatch(t.Context(), []BatchDocument{printoutDoc("p1", "a", new(atomic.Int32)), printoutDoc("p1", "b", new(atomic.Int32))})
+	if err != nil || report.Failed != 2 {
+		t.Fatalf("SendBatch() = %+v, %v", report, err)
+	}
+	for _, result := range report.Results {
+		if !errors.Is(result.Err, ErrNoHealthyPrinter) {
+			t.Errorf("document %d: %v", result.Index, result.Err)
+		}
+	}
+	if got := fakes["p2"].count("POST /api/v1/nf_printout"); got != 0 {
+		t.Errorf("backup received %d documents of the batch", got)
+	}
+	// Single documents still fail over.
+	if result, err := pool.SendNFPrintout("p1", &Printout{Lines: PrintoutLines{&TextLine{Text: "c"}}}, false); err != nil || result.Printer != "p2" {
+		t.Errorf("Send() = %q, %v", result.Printer, err)
+	}
+}
+
+func TestPoolSendValidatesOnce(t *testing.T) {
+	pool, _ := newTestPool(t, RetryPolicy{}, "p1")
+	var validations atomic.Int32
+	doc := printoutDoc("p1", "a", &validations)
+	if _, err := pool.SendContext(t.Context(), doc.Key, doc.Type, doc.Document, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := validations.Load(); got != 1 {
+		t.Errorf("document validated %d times, want 1", got)
+	}
+}
@@ -0,0 +1,66 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// CashIn records a float deposit into the drawer.
+type CashIn struct {
+	Value      string      `json:"value"`       // Value in currency, e.g. "100.00" Required: true
+	SystemInfo *SystemInfo `json:"system_info"` // Cashier the operation is attributed to Required: true
+}
+
+func (c *CashIn) Validate() error {
+	if err := validateCashValue(c.Value); err != nil {
+		return err
+	}
+	return validateCashier(c.SystemInfo)
+}
+
+// CashOut records a withdrawal from the drawer.
+type CashOut struct {
+	Value      string      `json:"value"`       // Value in currency, e.g. "100.00" Required: true
+	SystemInfo *SystemInfo `json:"system_info"` // Cashier the operation is attributed to Required: true
+}
+
+func (c *CashOut) Validate() error {
+	if err := validateCashValue(c.Value); err != nil {
+		return err
+	}
+	return validateCashier(c.SystemInfo)
+}
+
+// OpenDrawer opens the cash drawer without printing a document.
+type OpenDrawer struct {
+	SystemInfo *SystemInfo `json:"system_info,omitempty"`
+}
+
+func (o *OpenDrawer) Validate() error {
+	return nil
+}
+
+func validateCashValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("value is required")
+	}
+	decValue, err := decimal.NewFromString(value)
+	if err != nil {
+		return fmt.Errorf("invalid value format: %w", err)
+	}
+	if !decValue.IsPositive() {
+		return fmt.Errorf("value must be greater than 0")
+	}
+	if !decValue.Equal(decValue.Round(2)) {
+		return fmt.Errorf("value must have at most 2 decimal places")
+	}
+	return nil
+}
+
+func validateCashier(info *SystemInfo) error {
+	if info == nil || info.CashierName == "" {
+		return fmt.Errorf("system_info.cashier_name is required")
+	}
+	return nil
+}
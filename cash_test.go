@@ -0,0 +1,59 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCashValidate(t *testing.T) {
+	cashier := &SystemInfo{CashierName: "Anna"}
+	tests := []struct {
+		value   string
+		info    *SystemInfo
+		wantErr string
+	}{
+		{"100.00", cashier, ""},
+		{"0.01", cashier, ""},
+		{"5", cashier, ""},
+		{"", cashier, "value is required"},
+		{"abc", cashier, "invalid value format"},
+		{"0", cashier, "greater than 0"},
+		{"-1.00", cashier, "greater than 0"},
+		{"1.001", cashier, "2 decimal places"},
+		{"1.00", nil, "cashier_name is required"},
+		{"1.00", &SystemInfo{CashNumer: "1"}, "cashier_name is required"},
+	}
+	for _, tt := range tests {
+		for _, document := range []Document{&CashIn{Value: tt.value, SystemInfo: tt.info}, &CashOut{Value: tt.value, SystemInfo: tt.info}} {
+			err := document.Validate()
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("%T{%q}: Validate() = %v, want %q", document, tt.value, err, tt.wantErr)
+			}
+		}
+	}
+	if err := (&OpenDrawer{}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSendCashIn(t *testing.T) {
+	client, f := newFakeClient(t)
+	var body map[string]json.RawMessage
+	f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPost {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+		return false
+	})
+	if _, err := client.SendCashIn(&CashIn{Value: "50.00", SystemInfo: &SystemInfo{CashierName: "Anna"}}, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(body["cash_in"]); got != `{"value":"50.00","system_info":{"cashier_name":"Anna"}}` {
+		t.Errorf("body = %s", got)
+	}
+	if f.count("PUT /api/v1/cash_in/req1") != 1 {
+		t.Errorf("calls = %v", f.Calls())
+	}
+}
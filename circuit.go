@@ -0,0 +1,173 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"    // Requests go through
+	CircuitOpen     CircuitState = "open"      // Requests fail fast with ErrCircuitOpen
+	CircuitHalfOpen CircuitState = "half_open" // Trial requests decide whether to close or open again
+)
+
+// CircuitBreaker configures the circuit breaker of a client. A request
+// fails if it cannot reach the host or the host answers with a 5xx status;
+// errors reported by the API for the request itself do not count.
+type CircuitBreaker struct {
+	Failures         int                         // Consecutive failures that open the circuit. Defaults to 5
+	OpenFor          time.Duration               // How long the circuit stays open before a trial. Defaults to 30 seconds
+	HalfOpenRequests int                         // Concurrent trial requests when half open. Defaults to 1
+	OnStateChange    func(from, to CircuitState) // Called on every state change, e.g. to update a dashboard
+}
+
+// WithCircuitBreaker makes the client fail fast with ErrCircuitOpen while
+// the Novitus host is down instead of waiting for the timeout on every
+// call.
+func WithCircuitBreaker(breaker CircuitBreaker) ClientOption {
+	return func(n *NovitusClient) {
+		if breaker.Failures <= 0 {
+			breaker.Failures = 5
+		}
+		if breaker.OpenFor <= 0 {
+			breaker.OpenFor = 30 * time.Second
+		}
+		if breaker.HalfOpenRequests <= 0 {
+			breaker.HalfOpenRequests = 1
+		}
+		n.breaker = &circuitBreaker{config: breaker, state: CircuitClosed}
+	}
+}
+
+// CircuitState returns the state of the circuit breaker. It is always
+// CircuitClosed for clients without one.
+func (n *NovitusClient) CircuitState() CircuitState {
+	if n.breaker == nil {
+		return CircuitClosed
+	}
+	n.breaker.mu.Lock()
+	defer n.breaker.mu.Unlock()
+	if n.breaker.state == CircuitOpen && time.Since(n.breaker.openedAt) >= n.breaker.config.OpenFor {
+		return CircuitHalfOpen
+	}
+	return n.breaker.state
+}
+
+type circuitBreaker struct {
+	config   CircuitBreaker
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trials   int               // Trial requests in flight when half open
+	halfOpen int               // Number of times the circuit went half open
+	changes  [][2]CircuitState // State changes not passed to OnStateChange yet
+}
+
+// admission tells which half open period a request was admitted in as a
+// trial, 0 if it was admitted while the circuit was closed.
+type admission struct {
+	trialOf int
+}
+
+// allow fails with ErrCircuitOpen if the request must not be sent.
+func (b *circuitBreaker) allow() (admission, error) {
+	defer b.notify()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen {
+		if time.Since(b.openedAt) < b.config.OpenFor {
+			return admission{}, ErrCircuitOpen
+		}
+		b.setState(CircuitHalfOpen)
+		b.halfOpen++
+		b.trials = 0
+	}
+	if b.state == CircuitHalfOpen {
+		if b.trials >= b.config.HalfOpenRequests {
+			return admission{}, ErrCircuitOpen
+		}
+		b.trials++
+		return admission{trialOf: b.halfOpen}, nil
+	}
+	return admission{}, nil
+}
+
+// trial reports whether a is a trial of the current half open period.
+// Requests admitted before it, while the circuit was closed or in an
+// earlier half open period, neither free a trial slot nor decide the
+// state.
+func (b *circuitBreaker) trial(a admission) bool {
+	return b.state == CircuitHalfOpen && a.trialOf == b.halfOpen
+}
+
+func (b *circuitBreaker) record(a admission, failed bool) {
+	defer b.notify()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.config.Failures {
+			b.open()
+		}
+	case CircuitHalfOpen:
+		if !b.trial(a) {
+			return
+		}
+		b.trials--
+		if failed {
+			b.open()
+			return
+		}
+		b.failures = 0
+		b.setState(CircuitClosed)
+	}
+}
+
+// release ends a request that neither failed nor succeeded, e.g. because
+// its context was cancelled.
+func (b *circuitBreaker) release(a admission) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.trial(a) {
+		b.trials--
+	}
+}
+
+func (b *circuitBreaker) open() {
+	b.openedAt = time.Now()
+	b.setState(CircuitOpen)
+}
+
+func (b *circuitBreaker) setState(state CircuitState) {
+	if b.state != state && b.config.OnStateChange != nil {
+		b.changes = append(b.changes, [2]CircuitState{b.state, state})
+	}
+	b.state = state
+}
+
+// notify passes state changes to OnStateChange outside of the lock.
+func (b *circuitBreaker) notify() {
+	b.mu.Lock()
+	changes := b.changes
+	b.changes = nil
+	b.mu.Unlock()
+	for _, change := range changes {
+		b.config.OnStateChange(change[0], change[1])
+	}
+}
+
+func requestFailed(statusCode int, err error) bool {
+	return err != nil || statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,141 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var mu sync.Mutex
+	var changes []string
+	client, f := newFakeClient(t, WithCircuitBreaker(CircuitBreaker{
+		Failures: 2,
+		OpenFor:  20 * time.Millisecond,
+		OnStateChange: func(from, to CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			changes = append(changes, string(from)+">"+string(to))
+		},
+	}))
+	setFail := func(status int) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.fail = status
+	}
+
+	setFail(http.StatusBadRequest)
+	for range 3 {
+		client.GetQueueStatus()
+	}
+	if state := client.CircuitState(); state != CircuitClosed {
+		t.Fatalf("4xx answers opened the circuit: %s", state)
+	}
+
+	setFail(http.StatusServiceUnavailable)
+	for range 2 {
+		client.GetQueueStatus()
+	}
+	if state := client.CircuitState(); state != CircuitOpen {
+		t.Fatalf("state = %s after 2 failures", state)
+	}
+	calls := len(f.Calls())
+	if _, err := client.GetQueueStatus(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("open circuit = %v", err)
+	}
+	if len(f.Calls()) != calls {
+		t.Error("request was sent while the circuit is open")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if state := client.CircuitState(); state != CircuitHalfOpen {
+		t.Fatalf("state = %s after OpenFor", state)
+	}
+	client.GetQueueStatus()
+	if state := client.CircuitState(); state != CircuitOpen {
+		t.Fatalf("failed trial left the circuit %s", state)
+	}
+
+	setFail(0)
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.GetQueueStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if state := client.CircuitState(); state != CircuitClosed {
+		t.Fatalf("successful trial left the circuit %s", state)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := "closed>open,open>half_open,half_open>open,open>half_open,half_open>closed"
+	if got := strings.Join(changes, ","); got != want {
+		t.Errorf("state changes = %s, want %s", got, want)
+	}
+}
+
+func TestCircuitBreakerTrials(t *testing.T) {
+	breaker := &circuitBreaker{config: CircuitBreaker{Failures: 1, OpenFor: time.Millisecond, HalfOpenRequests: 2}, state: CircuitClosed}
+	breaker.record(admission{}, true)
+	time.Sleep(time.Millisecond)
+	var trials []admission
+	for i := range 2 {
+		trial, err := breaker.allow()
+		if err != nil {
+			t.Fatalf("trial %d: %v", i, err)
+		}
+		trials = append(trials, trial)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("third trial = %v", err)
+	}
+	breaker.release(trials[0])
+	if _, err := breaker.allow(); err != nil {
+		t.Errorf("trial after a released one = %v", err)
+	}
+}
+
+func TestCircuitBreakerLateRequests(t *testing.T) {
+	breaker := &circuitBreaker{config: CircuitBreaker{Failures: 1, OpenFor: time.Millisecond, HalfOpenRequests: 1}, state: CircuitClosed}
+	// Admitted while closed, they finish after the circuit went half open.
+	slow, _ := breaker.allow()
+	cancelled, _ := breaker.allow()
+	breaker.record(admission{}, true)
+	time.Sleep(time.Millisecond)
+	trial, err := breaker.allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	breaker.release(cancelled)
+	breaker.record(slow, false)
+	if breaker.state != CircuitHalfOpen || breaker.trials != 1 {
+		t.Fatalf("late requests changed the breaker: state %s, %d trials", breaker.state, breaker.trials)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("second trial = %v, want ErrCircuitOpen", err)
+	}
+	breaker.record(trial, false)
+	if breaker.state != CircuitClosed || breaker.trials != 0 {
+		t.Errorf("after the trial: state %s, %d trials", breaker.state, breaker.trials)
+	}
+}
+
+func TestRequestFailed(t *testing.T) {
+	tests := []struct {
+		status int
+		err    error
+		want   bool
+	}{
+		{http.StatusOK, nil, false},
+		{http.StatusNotFound, nil, false},
+		{http.StatusInternalServerError, nil, true},
+		{0, errors.New("connection refused"), true},
+	}
+	for _, tt := range tests {
+		if got := requestFailed(tt.status, tt.err); got != tt.want {
+			t.Errorf("requestFailed(%d, %v) = %v", tt.status, tt.err, got)
+		}
+	}
+}
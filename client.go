@@ -1,8 +1,10 @@
 package novitus_gosdk
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"resty.dev/v3"
@@ -12,13 +14,31 @@ type NovitusClient struct {
 	host                string
 	token               string
 	tokenExpirationDate int64
+	backpressure        *Backpressure
+	limiter             *rateLimiter
+	breaker             *circuitBreaker
+	async               *asyncPoller
+	events              *eventBus
+	lazyToken           bool       // Set by WithLazyToken
+	refreshMu           sync.Mutex // Serializes RefreshIfNeeded so concurrent calls refresh once
+	mu                  sync.Mutex
+	health              DeviceHealth
+	queueDepth          int
+	queueCheckedAt      time.Time
 }
 
-func NewNovitusClient(host, token string) (*NovitusClient, error) {
+func NewNovitusClient(host, token string, options ...ClientOption) (*NovitusClient, error) {
 	client := &NovitusClient{
-		host: host,
+		host:   host,
+		events: newEventBus(),
 	}
-	if token != "" {
+	for _, option := range options {
+		option(client)
+	}
+	if client.async == nil {
+		client.async = newAsyncPoller(client, AsyncOptions{})
+	}
+	if token != "" || client.lazyToken {
 		client.token = token
 		return client, nil
 	}
@@ -34,60 +54,81 @@ func (n *NovitusClient) ObtainToken() (TokenResponse, error) {
 	defer client.Close()
 	var tokenResponse TokenResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().SetResult(&tokenResponse).SetError(&errorResponse).Get(n.host + "/api/v1/token")
+	request := client.R().SetResult(&tokenResponse).SetError(&errorResponse)
+	res, err := n.execute(context.Background(), requestSubmit, request, resty.MethodGet, n.host+"/api/v1/token")
 	if err != nil {
 		return TokenResponse{}, fmt.Errorf("failed to obtain token: %w", err)
 	}
 	if res.IsError() {
-		return TokenResponse{}, fmt.Errorf("error obtaining token: %s", errorResponse.Exception.Description)
+		return TokenResponse{}, fmt.Errorf("error obtaining token: %w", newStatusError(res, errorResponse))
 	}
 	t, err := time.Parse(time.RFC3339, tokenResponse.ExpirationDate)
 	if err != nil {
-		return TokenResponse{}, fmt.Errorf("Failed to parse expiration date", err)
+		return TokenResponse{}, fmt.Errorf("failed to parse expiration date: %w", err)
 	}
-	n.tokenExpirationDate = t.Unix()
-	n.token = tokenResponse.Token
+	n.setToken(tokenResponse.Token, t)
 	return tokenResponse, nil
 }
 
+// Token returns the current token and its expiration time.
+func (n *NovitusClient) Token() (string, time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.token, time.Unix(n.tokenExpirationDate, 0)
+}
+
+func (n *NovitusClient) setToken(token string, expiration time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.token = token
+	n.tokenExpirationDate = expiration.Unix()
+}
+
+func (n *NovitusClient) bearer() string {
+	token, _ := n.Token()
+	return "Bearer " + token
+}
+
 func (n *NovitusClient) RefreshToken() error {
 	client := resty.New()
 	defer client.Close()
 	var tokenResponse TokenResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().SetResult(&tokenResponse).SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Patch(n.host + "/api/v1/token")
+	request := client.R().SetResult(&tokenResponse).SetError(&errorResponse).
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestSubmit, request, resty.MethodPatch, n.host+"/api/v1/token")
 	if err != nil {
 		return fmt.Errorf("failed to refresh token: %w", err)
 	}
 	if res.IsError() {
-		return fmt.Errorf("error refreshing token: %s", errorResponse.Exception.Description)
+		return fmt.Errorf("error refreshing token: %w", newStatusError(res, errorResponse))
 	}
-	n.token = tokenResponse.Token
 	t, err := time.Parse(time.RFC3339, tokenResponse.ExpirationDate)
 	if err != nil {
 		return fmt.Errorf("failed to parse expiration date: %w", err)
 	}
-	n.tokenExpirationDate = t.Unix()
+	n.setToken(tokenResponse.Token, t)
 	return nil
 
 }
 
 func (n *NovitusClient) RefreshIfNeeded() error {
-	if n.token == "" {
+	n.refreshMu.Lock()
+	defer n.refreshMu.Unlock()
+	token, expiration := n.Token()
+	if token == "" {
 		_, err := n.ObtainToken()
 		return err
 	}
 	currentTime := time.Now().Unix()
-	if currentTime >= n.tokenExpirationDate {
+	if currentTime >= expiration.Unix() {
 		err := n.RefreshToken()
 		if err != nil {
 			_, err := n.ObtainToken()
 			return err
 		}
 	}
-	if n.tokenExpirationDate-currentTime < 300 { // Refresh if less than 5 minutes left
+	if _, expiration := n.Token(); expiration.Unix()-currentTime < 300 { // Refresh if less than 5 minutes left
 		return n.RefreshToken()
 	}
 	return nil
@@ -102,15 +143,19 @@ func (n *NovitusClient) GetQueueStatus() (QueueResponse, error) {
 	defer client.Close()
 	var queueResponse QueueResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().SetResult(&queueResponse).SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Get(n.host + "/api/v1/queue")
+	request := client.R().SetResult(&queueResponse).SetError(&errorResponse).
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestPoll, request, resty.MethodGet, n.host+"/api/v1/queue")
 	if err != nil {
 		return QueueResponse{}, fmt.Errorf("failed to get queue status: %w", err)
 	}
 	if res.IsError() {
-		return QueueResponse{}, fmt.Errorf("error getting queue status: %s", errorResponse.Exception.Description)
+		return QueueResponse{}, fmt.Errorf("error getting queue status: %w", newStatusError(res, errorResponse))
 	}
+	n.mu.Lock()
+	n.queueDepth = queueResponse.RequestsInQueue
+	n.queueCheckedAt = time.Now()
+	n.mu.Unlock()
 	return queueResponse, nil
 }
 
@@ -123,19 +168,25 @@ func (n *NovitusClient) DeleteQueue() (DeleteQueueResponse, error) {
 	defer client.Close()
 	var deleteQueueResponse DeleteQueueResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().SetResult(&deleteQueueResponse).SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Delete(n.host + "/api/v1/queue")
+	request := client.R().SetResult(&deleteQueueResponse).SetError(&errorResponse).
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestSubmit, request, resty.MethodDelete, n.host+"/api/v1/queue")
 	if err != nil {
 		return DeleteQueueResponse{}, fmt.Errorf("failed to delete queue: %w", err)
 	}
 	if res.IsError() {
-		return DeleteQueueResponse{}, fmt.Errorf("error deleting queue: %s", errorResponse.Exception.Description)
+		return DeleteQueueResponse{}, fmt.Errorf("error deleting queue: %w", newStatusError(res, errorResponse))
 	}
 	return deleteQueueResponse, nil
 }
 
 func (n *NovitusClient) Confirm(objectType, requestId string) (SendDocumentResponse, error) {
+	confirmResponse, err := n.confirm(objectType, requestId)
+	n.events.publish(Event{Type: EventConfirmed, DocumentType: objectType, RequestId: requestId, Status: confirmResponse.Request.Status, Err: err})
+	return confirmResponse, err
+}
+
+func (n *NovitusClient) confirm(objectType, requestId string) (SendDocumentResponse, error) {
 	err := n.RefreshIfNeeded()
 	if err != nil {
 		return SendDocumentResponse{}, fmt.Errorf("failed to refresh token before confirming document: %w", err)
@@ -144,24 +195,46 @@ func (n *NovitusClient) Confirm(objectType, requestId string) (SendDocumentRespo
 	defer client.Close()
 	var confirmResponse SendDocumentResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().
+	request := client.R().
 		SetResult(&confirmResponse).
 		SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Put(n.host + "/api/v1/" + objectType + "/" + requestId)
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestSubmit, request, resty.MethodPut, n.host+"/api/v1/"+objectType+"/"+requestId)
 	if err != nil {
 		return SendDocumentResponse{}, fmt.Errorf("failed to confirm document: %w", err)
 	}
 	if res.IsError() {
-		return SendDocumentResponse{}, fmt.Errorf("error confirming document: %s", errorResponse.Exception.Description)
+		return SendDocumentResponse{}, fmt.Errorf("error confirming document: %w", newStatusError(res, errorResponse))
 	}
 	return confirmResponse, nil
 }
 
 func (n *NovitusClient) SendDocument(documentType string, document Document) (SendDocumentResponse, error) {
-	err := document.Validate()
+	return n.SendDocumentContext(context.Background(), documentType, document)
+}
+
+// SendDocumentContext is SendDocument with a context, which also bounds
+// the wait for a free queue when backpressure is enabled.
+func (n *NovitusClient) SendDocumentContext(ctx context.Context, documentType string, document Document) (SendDocumentResponse, error) {
+	if err := document.Validate(); err != nil {
+		err = fmt.Errorf("%w: %w", ErrValidation, err)
+		n.events.publish(Event{Type: EventSent, DocumentType: documentType, Err: err})
+		return SendDocumentResponse{}, err
+	}
+	return n.sendValidated(ctx, documentType, document)
+}
+
+// sendValidated sends a document its caller has validated already.
+func (n *NovitusClient) sendValidated(ctx context.Context, documentType string, document Document) (SendDocumentResponse, error) {
+	sendDocumentResponse, err := n.sendDocument(ctx, documentType, document)
+	n.events.publish(Event{Type: EventSent, DocumentType: documentType, RequestId: sendDocumentResponse.Request.Id, Status: sendDocumentResponse.Request.Status, Err: err})
+	return sendDocumentResponse, err
+}
+
+func (n *NovitusClient) sendDocument(ctx context.Context, documentType string, document Document) (SendDocumentResponse, error) {
+	err := n.waitForQueue(ctx)
 	if err != nil {
-		return SendDocumentResponse{}, fmt.Errorf("Validation Error: %w", err)
+		return SendDocumentResponse{}, err
 	}
 	err = n.RefreshIfNeeded()
 	if err != nil {
@@ -177,19 +250,22 @@ func (n *NovitusClient) SendDocument(documentType string, document Document) (Se
 	} else {
 		body[documentType] = document
 	}
-	res, err := client.R().
+	request := client.R().
 		SetResult(&sendDocumentResponse).
 		SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		SetBody(body).
-		Post(n.host + "/api/v1/" + documentType)
+		SetHeader("Authorization", n.bearer()).
+		SetBody(body)
+	res, err := n.execute(ctx, requestSubmit, request, resty.MethodPost, n.host+"/api/v1/"+documentType)
 
 	if err != nil {
 		return SendDocumentResponse{}, fmt.Errorf("failed to send document: %w", err)
 	}
 	if res.IsError() {
-		return SendDocumentResponse{}, fmt.Errorf("error sending document: %s, %s", errorResponse.Exception.Description, strings.Join(errorResponse.Exception.Errors, ", "))
+		return SendDocumentResponse{}, fmt.Errorf("error sending document: %w, %s", newStatusError(res, errorResponse), strings.Join(errorResponse.Exception.Errors, ", "))
 	}
+	n.mu.Lock()
+	n.queueDepth++
+	n.mu.Unlock()
 	return sendDocumentResponse, nil
 }
 
@@ -202,20 +278,84 @@ func (n *NovitusClient) CheckDocumentStatus(objectType, requestId string) (Check
 	defer client.Close()
 	var checkDocumentStatusResponse CheckDocumentStatusResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().
+	request := client.R().
 		SetResult(&checkDocumentStatusResponse).
 		SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Get(n.host + "/api/v1/" + objectType + "/" + requestId)
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestPoll, request, resty.MethodGet, n.host+"/api/v1/"+objectType+"/"+requestId)
 	if err != nil {
 		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to check document status: %w", err)
 	}
 	if res.IsError() {
-		return CheckDocumentStatusResponse{}, fmt.Errorf("error checking document status: %s", errorResponse.Exception.Description)
+		return CheckDocumentStatusResponse{}, fmt.Errorf("error checking document status: %w", newStatusError(res, errorResponse))
 	}
+	if checkDocumentStatusResponse.DeviceObj.Status != "" {
+		n.updateHealth(func(health *DeviceHealth) {
+			health.Status = checkDocumentStatusResponse.DeviceObj.Status
+			health.Info.Error = checkDocumentStatusResponse.DeviceObj.Error
+			health.Err = nil
+		})
+	}
+	n.events.statusChecked(objectType, checkDocumentStatusResponse)
 	return checkDocumentStatusResponse, nil
 }
 
+// WaitForDocument polls the document status every interval until the
+// request is finished (DONE or ERROR) or ctx is done.
+func (n *NovitusClient) WaitForDocument(ctx context.Context, objectType, requestId string, interval time.Duration) (CheckDocumentStatusResponse, error) {
+	if interval <= 0 {
+		return CheckDocumentStatusResponse{}, fmt.Errorf("interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := n.CheckDocumentStatus(objectType, requestId)
+		if err != nil {
+			return CheckDocumentStatusResponse{}, err
+		}
+		if status.IsFinished() {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("stopped waiting for document %s: %w", requestId, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *NovitusClient) GetDeviceInfo() (DeviceInfoResponse, error) {
+	err := n.RefreshIfNeeded()
+	if err != nil {
+		return DeviceInfoResponse{}, fmt.Errorf("failed to refresh token before getting device info: %w", err)
+	}
+	client := resty.New()
+	defer client.Close()
+	var deviceInfoResponse DeviceInfoResponse
+	var errorResponse ErrorResponse
+	request := client.R().
+		SetResult(&deviceInfoResponse).
+		SetError(&errorResponse).
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestPoll, request, resty.MethodGet, n.host+"/api/v1/device")
+	if err != nil {
+		err = fmt.Errorf("failed to get device info: %w", err)
+	} else if res.IsError() {
+		err = fmt.Errorf("error getting device info: %w", newStatusError(res, errorResponse))
+	}
+	n.updateHealth(func(health *DeviceHealth) {
+		health.Err = err
+		if err == nil {
+			health.Info = deviceInfoResponse.Device
+			health.Status = deviceInfoResponse.Device.Status
+		}
+	})
+	if err != nil {
+		return DeviceInfoResponse{}, err
+	}
+	return deviceInfoResponse, nil
+}
+
 func (n *NovitusClient) DeleteDocument(objectType, requestId string) (DeleteDocumentResponse, error) {
 	err := n.RefreshIfNeeded()
 	if err != nil {
@@ -225,58 +365,134 @@ func (n *NovitusClient) DeleteDocument(objectType, requestId string) (DeleteDocu
 	defer client.Close()
 	var deleteDocumentResponse DeleteDocumentResponse
 	var errorResponse ErrorResponse
-	res, err := client.R().
+	request := client.R().
 		SetResult(&deleteDocumentResponse).
 		SetError(&errorResponse).
-		SetHeader("Authorization", "Bearer "+n.token).
-		Delete(n.host + "/api/v1/" + objectType + "/" + requestId)
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestSubmit, request, resty.MethodDelete, n.host+"/api/v1/"+objectType+"/"+requestId)
 	if err != nil {
 		return DeleteDocumentResponse{}, fmt.Errorf("failed to delete document: %w", err)
 	}
 	if res.IsError() {
-		return DeleteDocumentResponse{}, fmt.Errorf("error deleting document: %s", errorResponse.Exception.Description)
+		return DeleteDocumentResponse{}, fmt.Errorf("error deleting document: %w", newStatusError(res, errorResponse))
 	}
+	n.events.publish(Event{Type: EventDeleted, DocumentType: objectType, RequestId: requestId, Status: deleteDocumentResponse.Request.Status})
 	return deleteDocumentResponse, nil
 }
 
 func (n *NovitusClient) SendReceipt(receipt *Receipt, confirm bool) (CheckDocumentStatusResponse, error) {
-	sendDocumentResponse, err := n.SendDocument("receipt", receipt)
+	return n.sendAndCheck("receipt", "receipt", receipt, confirm)
+}
+
+func (n *NovitusClient) SendInvoice(invoice *Invoice, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("invoice", "invoice", invoice, confirm)
+}
+
+func (n *NovitusClient) SendNFPrintout(printout *Printout, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("nf_printout", "printout", printout, confirm)
+}
+
+func (n *NovitusClient) SendDailyReport(report *DailyReport, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("daily_report", "daily report", report, confirm)
+}
+
+func (n *NovitusClient) SendPeriodicReport(report *PeriodicReport, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("periodic_report", "periodic report", report, confirm)
+}
+
+func (n *NovitusClient) SendCashIn(cashIn *CashIn, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("cash_in", "cash in", cashIn, confirm)
+}
+
+func (n *NovitusClient) SendCashOut(cashOut *CashOut, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("cash_out", "cash out", cashOut, confirm)
+}
+
+func (n *NovitusClient) OpenCashDrawer(openDrawer *OpenDrawer, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("open_drawer", "open drawer", openDrawer, confirm)
+}
+
+// sendAndCheck sends the document, optionally confirms it and returns its
+// current status. name is used in error messages.
+func (n *NovitusClient) sendAndCheck(documentType, name string, document Document, confirm bool) (CheckDocumentStatusResponse, error) {
+	sendDocumentResponse, err := n.SendDocument(documentType, document)
 	if err != nil {
-		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to send receipt: %w", err)
+		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to send %s: %w", name, err)
 	}
 	if confirm {
-		_, err := n.Confirm("receipt", sendDocumentResponse.Request.Id)
+		_, err := n.Confirm(documentType, sendDocumentResponse.Request.Id)
 		if err != nil {
 			return CheckDocumentStatusResponse{}, fmt.Errorf("failed to confirm document: %w", err)
 		}
 	}
-	return n.CheckDocumentStatus("receipt", sendDocumentResponse.Request.Id)
+	return n.CheckDocumentStatus(documentType, sendDocumentResponse.Request.Id)
 }
 
-func (n *NovitusClient) SendInvoice(invoice *Invoice, confirm bool) (CheckDocumentStatusResponse, error) {
-	sendDocumentResponse, err := n.SendDocument("invoice", invoice)
+// GetJPK fetches the JPK record with the given id. Experimental: the
+// /api/v1/jpk/{id} endpoint is not part of the API description and may not
+// be available on every printer.
+func (n *NovitusClient) GetJPK(jpkId JPKID) (JPKResponse, error) {
+	if jpkId.IsZero() {
+		return JPKResponse{}, fmt.Errorf("jpk id is required")
+	}
+	err := n.RefreshIfNeeded()
 	if err != nil {
-		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to send invoice: %w", err)
+		return JPKResponse{}, fmt.Errorf("failed to refresh token before getting jpk: %w", err)
 	}
-	if confirm {
-		_, err := n.Confirm("invoice", sendDocumentResponse.Request.Id)
-		if err != nil {
-			return CheckDocumentStatusResponse{}, fmt.Errorf("failed to confirm document: %w", err)
-		}
+	client := resty.New()
+	defer client.Close()
+	var jpkResponse JPKResponse
+	var errorResponse ErrorResponse
+	request := client.R().
+		SetResult(&jpkResponse).
+		SetError(&errorResponse).
+		SetHeader("Authorization", n.bearer())
+	res, err := n.execute(context.Background(), requestPoll, request, resty.MethodGet, n.host+"/api/v1/jpk/"+jpkId.String())
+	if err != nil {
+		return JPKResponse{}, fmt.Errorf("failed to get jpk: %w", err)
+	}
+	if res.IsError() {
+		return JPKResponse{}, fmt.Errorf("error getting jpk: %w", newStatusError(res, errorResponse))
 	}
-	return n.CheckDocumentStatus("invoice", sendDocumentResponse.Request.Id)
+	return jpkResponse, nil
 }
 
-func (n *NovitusClient) SendNFPrintout(printout *Printout, confirm bool) (CheckDocumentStatusResponse, error) {
-	sendDocumentResponse, err := n.SendDocument("nf_printout", printout)
+func newStatusError(res *resty.Response, errorResponse ErrorResponse) *StatusError {
+	return &StatusError{StatusCode: res.StatusCode(), Exception: errorResponse.Exception}
+}
+
+type requestKind int
+
+const (
+	requestSubmit requestKind = iota // Documents, confirms, deletes and token calls
+	requestPoll                      // Status, queue, device and JPK queries
+)
+
+// execute is the common path of every call to the Novitus host. It waits
+// for the rate limiter and checks the circuit breaker before sending the
+// request.
+func (n *NovitusClient) execute(ctx context.Context, kind requestKind, request *resty.Request, method, url string) (*resty.Response, error) {
+	if n.limiter != nil {
+		if err := n.limiter.wait(ctx, kind); err != nil {
+			return nil, err
+		}
+	}
+	if n.breaker == nil {
+		return request.SetContext(ctx).Execute(method, url)
+	}
+	admitted, err := n.breaker.allow()
 	if err != nil {
-		return CheckDocumentStatusResponse{}, fmt.Errorf("failed to send printout: %w", err)
+		return nil, err
 	}
-	if confirm {
-		_, err := n.Confirm("nf_printout", sendDocumentResponse.Request.Id)
-		if err != nil {
-			return CheckDocumentStatusResponse{}, fmt.Errorf("failed to confirm document: %w", err)
-		}
+	res, err := request.SetContext(ctx).Execute(method, url)
+	if ctx.Err() != nil {
+		n.breaker.release(admitted)
+		return res, err
+	}
+	statusCode := 0
+	if res != nil {
+		statusCode = res.StatusCode()
 	}
-	return n.CheckDocumentStatus("nf_printout", sendDocumentResponse.Request.Id)
+	n.breaker.record(admitted, requestFailed(statusCode, err))
+	return res, err
 }
@@ -0,0 +1,154 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeNovitus is an in-memory Novitus host. Sent documents are STORED,
+// confirmed ones become DONE on the next status check.
+type fakeNovitus struct {
+	mu         sync.Mutex
+	next       int
+	statuses   map[string]string
+	edocuments map[string]string
+	calls      []string
+	fail       int // Respond to every call with this HTTP status if set
+	queue      int
+	// handle, if set, is called first and may answer the request itself.
+	handle func(w http.ResponseWriter, r *http.Request) bool
+}
+
+func newFake(t *testing.T) (*fakeNovitus, *httptest.Server) {
+	f := &fakeNovitus{statuses: map[string]string{}, edocuments: map[string]string{}}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+// newFakeClient returns a client with a valid token connected to a new
+// fake host.
+func newFakeClient(t *testing.T, options ...ClientOption) (*NovitusClient, *fakeNovitus) {
+	f, srv := newFake(t)
+	client, err := NewNovitusClient(srv.URL, "", options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, f
+}
+
+func (f *fakeNovitus) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+	handle := f.handle
+	f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if handle != nil && handle(w, r) {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail != 0 {
+		w.WriteHeader(f.fail)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"boom"}}`)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	switch {
+	case parts[0] == "token":
+		json.NewEncoder(w).Encode(map[string]string{"token": "t", "expiration_date": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	case parts[0] == "queue":
+		json.NewEncoder(w).Encode(map[string]interface{}{"requests_in_queue": f.queue})
+	case parts[0] == "device":
+		fmt.Fprint(w, `{"device":{"status":"OK"}}`)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		f.next++
+		id := fmt.Sprint("req", f.next)
+		f.statuses[id] = RequestStatusStored
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"STORED"}}`, id)
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		f.statuses[parts[1]] = RequestStatusConfirmed
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"CONFIRMED"}}`, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		status, ok := f.statuses[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":{"code":404,"description":"not found"}}`)
+			return
+		}
+		if status == RequestStatusConfirmed {
+			f.statuses[parts[1]] = RequestStatusDone
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"device":  map[string]string{"status": "OK"},
+			"request": map[string]string{"id": parts[1], "status": status, "e_document": f.edocuments[parts[1]]},
+		})
+	case len(parts) == 2 && r.Method == http.MethodDelete:
+		delete(f.statuses, parts[1])
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"DELETED"}}`, parts[1])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"exception":{"code":404,"description":"unknown"}}`)
+	}
+}
+
+func (f *fakeNovitus) setHandle(handle func(w http.ResponseWriter, r *http.Request) bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handle = handle
+}
+
+func (f *fakeNovitus) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+func (f *fakeNovitus) count(call string) int {
+	count := 0
+	for _, c := range f.Calls() {
+		if c == call {
+			count++
+		}
+	}
+	return count
+}
+
+func sampleReceipt() *Receipt {
+	return &Receipt{Items: []interface{}{article("A", "1.00")}, Summary: Summary{Total: "1.00"}}
+}
+
+func TestSendReceipt(t *testing.T) {
+	client, f := newFakeClient(t)
+	status, err := client.SendReceipt(sampleReceipt(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Request.Status != RequestStatusConfirmed {
+		t.Errorf("status = %s", status.Request.Status)
+	}
+	status, err = client.CheckDocumentStatus("receipt", status.Request.Id)
+	if err != nil || status.Request.Status != RequestStatusDone {
+		t.Fatalf("status = %s, %v", status.Request.Status, err)
+	}
+	want := []string{"GET /api/v1/token", "POST /api/v1/receipt", "PUT /api/v1/receipt/req1", "GET /api/v1/receipt/req1", "GET /api/v1/receipt/req1"}
+	if got := f.Calls(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+}
+
+func TestSendDocumentValidates(t *testing.T) {
+	client, f := newFakeClient(t)
+	if _, err := client.SendReceipt(&Receipt{}, true); err == nil {
+		t.Fatal("expected a validation error")
+	}
+	if f.count("POST /api/v1/receipt") != 0 {
+		t.Error("invalid receipt was sent")
+	}
+}
@@ -0,0 +1,212 @@
+// Command novitus-gateway exposes Novitus fiscal printers over a small REST
+// API, so services in other languages can print without implementing the
+// Novitus protocol.
+//
+// Documents are validated, stored in a file-backed outbox and delivered to
+// the printers of a pool in the background; clients poll for the final
+// status. See readme.md for the API.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+)
+
+type printerConfig struct {
+	Host  string `json:"host"`
+	Token string `json:"token,omitempty"`
+}
+
+type config struct {
+	Printers      map[string]printerConfig `json:"printers"`
+	Routes        map[string]string        `json:"routes,omitempty"`         // Route key -> printer name
+	FiscalBackups map[string][]string      `json:"fiscal_backups,omitempty"` // Route key -> backup printers for fiscal documents
+	RetryAttempts int                      `json:"retry_attempts,omitempty"`
+	RetryDelay    string                   `json:"retry_delay,omitempty"` // e.g. "200ms"
+	Failover      *struct {
+		MaxErrors int `json:"max_errors,omitempty"`
+		MaxQueue  int `json:"max_queue,omitempty"`
+	} `json:"failover,omitempty"`
+}
+
+func main() {
+	listen := flag.String("listen", envOr("NOVITUS_GATEWAY_LISTEN", "127.0.0.1:8080"), "address to listen on (env NOVITUS_GATEWAY_LISTEN)")
+	token := flag.String("token", os.Getenv("NOVITUS_GATEWAY_TOKEN"), "bearer token required by the API, none if empty (env NOVITUS_GATEWAY_TOKEN)")
+	configPath := flag.String("config", envOr("NOVITUS_GATEWAY_CONFIG", "gateway.json"), "printer configuration file (env NOVITUS_GATEWAY_CONFIG)")
+	outboxDir := flag.String("outbox", envOr("NOVITUS_GATEWAY_OUTBOX", "outbox"), "outbox directory (env NOVITUS_GATEWAY_OUTBOX)")
+	workers := flag.Int("workers", 4, "number of delivery workers")
+	attempts := flag.Int("attempts", 5, "delivery attempts before a document fails")
+	finalTimeout := flag.Duration("final-timeout", 2*time.Minute, "how long to wait for a printed document to reach DONE or ERROR")
+	retention := flag.Duration("retention", 7*24*time.Hour, "how long finished documents and their idempotency keys are kept")
+	flag.Parse()
+
+	if err := run(*listen, *token, *configPath, *outboxDir, *workers, *attempts, *finalTimeout, *retention); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(listen, token, configPath, outboxDir string, workers, attempts int, finalTimeout, retention time.Duration) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	pool, err := newPool(cfg)
+	if err != nil {
+		return err
+	}
+	box, err := openOutbox(outboxDir)
+	if err != nil {
+		return err
+	}
+	checkPrinters(pool)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	delivery := &deliverer{pool: pool, outbox: box, attempts: attempts, finalTimeout: finalTimeout}
+	delivery.start(ctx, workers)
+	go refreshTokens(ctx, pool)
+	go pool.RunProbes(ctx)
+	go pruneOutbox(ctx, box, retention)
+
+	if token == "" {
+		log.Printf("no -token set, the API on %s accepts requests without authentication", listen)
+	}
+	server := &http.Server{Addr: listen, Handler: newServer(pool, box, token).routes(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	log.Printf("novitus-gateway listening on %s with %d printers", listen, len(cfg.Printers))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	delivery.wait()
+	return nil
+}
+
+func loadConfig(path string) (config, error) {
+	var cfg config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if len(cfg.Printers) == 0 {
+		return cfg, fmt.Errorf("config has no printers")
+	}
+	return cfg, nil
+}
+
+func newPool(cfg config) (*novitus.Pool, error) {
+	retry := novitus.RetryPolicy{Attempts: cfg.RetryAttempts}
+	if cfg.RetryDelay != "" {
+		delay, err := time.ParseDuration(cfg.RetryDelay)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_delay: %w", err)
+		}
+		retry.Delay = delay
+	}
+	pool := novitus.NewPool(retry)
+	for name, printer := range cfg.Printers {
+		// Tokens are obtained on first use, so an offline printer does not
+		// stop the gateway from starting.
+		if _, err := pool.Add(name, printer.Host, printer.Token, novitus.WithLazyToken()); err != nil {
+			return nil, err
+		}
+	}
+	for key, name := range cfg.Routes {
+		if err := pool.Route(key, name); err != nil {
+			return nil, fmt.Errorf("route %s: %w", key, err)
+		}
+	}
+	if cfg.Failover != nil {
+		pool.SetFailover(novitus.FailoverPolicy{MaxErrors: cfg.Failover.MaxErrors, MaxQueue: cfg.Failover.MaxQueue})
+		for key, names := range cfg.FiscalBackups {
+			if err := pool.AllowFiscal(key, names...); err != nil {
+				return nil, fmt.Errorf("fiscal backups of %s: %w", key, err)
+			}
+		}
+	}
+	return pool, nil
+}
+
+// checkPrinters obtains the token of every printer and marks the printers
+// that cannot be reached unhealthy, until RunProbes re-admits them.
+func checkPrinters(pool *novitus.Pool) {
+	var wg sync.WaitGroup
+	for _, name := range pool.Names() {
+		client, ok := pool.Client(name)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.RefreshIfNeeded(); err != nil {
+				log.Printf("printer %s is unavailable: %v", name, err)
+				pool.MarkUnhealthy(name, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// pruneOutbox removes finished documents older than retention at start and
+// then every hour.
+func pruneOutbox(ctx context.Context, box *outbox, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		if removed, err := box.prune(time.Now().Add(-retention)); err != nil {
+			log.Printf("failed to prune outbox: %v", err)
+		} else if removed > 0 {
+			log.Printf("pruned %d finished documents from outbox", removed)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshTokens keeps the tokens of all printers fresh, so requests do not
+// wait for a token refresh.
+func refreshTokens(ctx context.Context, pool *novitus.Pool) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pool.RefreshTokens(); err != nil {
+				log.Printf("failed to refresh tokens: %v", err)
+			}
+		}
+	}
+}
+
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,492 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+)
+
+type entryState string
+
+const (
+	stateQueued  entryState = "queued"  // Waiting for delivery
+	stateSending entryState = "sending" // Being sent to a printer
+	stateSent    entryState = "sent"    // Confirmed on a printer, waiting for DONE or ERROR
+	stateDone    entryState = "done"    // Printed
+	stateFailed  entryState = "failed"  // Delivery or printing failed, see Error
+	stateUnknown entryState = "unknown" // May be stored or printed, check the printer before sending the document again
+)
+
+// errIdConflict is returned by add for an id already used by a different
+// document.
+var errIdConflict = errors.New("id is already used by a different document")
+
+// entry is a document in the outbox. Every change is written to disk
+// before it takes effect, so delivery resumes after a restart.
+type entry struct {
+	Id          string                               `json:"id"`
+	Key         string                               `json:"key"`
+	Type        string                               `json:"type"`
+	Document    json.RawMessage                      `json:"document"`
+	State       entryState                           `json:"state"`
+	Printer     string                               `json:"printer,omitempty"`
+	RequestId   string                               `json:"request_id,omitempty"`
+	Status      *novitus.CheckDocumentStatusResponse `json:"status,omitempty"`
+	Error       string                               `json:"error,omitempty"`
+	Attempts    int                                  `json:"attempts"`
+	NextAttempt time.Time                            `json:"next_attempt,omitempty"`
+	CreatedAt   time.Time                            `json:"created_at"`
+	UpdatedAt   time.Time                            `json:"updated_at"`
+}
+
+type outbox struct {
+	dir     string
+	mu      sync.Mutex
+	entries map[string]*entry
+	wake    chan struct{}
+}
+
+// openOutbox loads the entries stored in dir. Entries that were being sent
+// when the gateway stopped are marked unknown instead of sent again: the
+// printer may have stored them already and a second send could print the
+// document twice.
+func openOutbox(dir string) (*outbox, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox: %w", err)
+	}
+	o := &outbox{dir: dir, entries: make(map[string]*entry), wake: make(chan struct{}, 1)}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox: %w", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox entry: %w", err)
+		}
+		e := &entry{}
+		if err := json.Unmarshal(data, e); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox entry %s: %w", file, err)
+		}
+		if e.State == stateSending {
+			e.State = stateUnknown
+			e.Error = "delivery was interrupted, check the printer before sending the document again"
+			if err := o.save(e); err != nil {
+				return nil, err
+			}
+		}
+		o.entries[e.Id] = e
+	}
+	return o, nil
+}
+
+// add stores a new entry. If an entry with the same id and document
+// exists, it is returned instead, so clients can retry a request safely;
+// an entry with the same id and another document fails with errIdConflict.
+func (o *outbox) add(e *entry) (entry, bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if e.Id == "" {
+		e.Id = newId()
+	} else if existing, ok := o.entries[e.Id]; ok {
+		if existing.Key != e.Key || existing.Type != e.Type || !sameJSON(existing.Document, e.Document) {
+			return entry{}, false, errIdConflict
+		}
+		return *existing, false, nil
+	}
+	e.State = stateQueued
+	e.CreatedAt = time.Now()
+	e.UpdatedAt = e.CreatedAt
+	if err := o.save(e); err != nil {
+		return entry{}, false, err
+	}
+	o.entries[e.Id] = e
+	o.notify()
+	return *e, true, nil
+}
+
+func (o *outbox) get(id string) (entry, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	e, ok := o.entries[id]
+	if !ok {
+		return entry{}, false
+	}
+	return *e, true
+}
+
+// update changes the entry and writes it to disk.
+func (o *outbox) update(id string, change func(e *entry)) (entry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	e, ok := o.entries[id]
+	if !ok {
+		return entry{}, fmt.Errorf("unknown outbox entry %s", id)
+	}
+	updated := *e
+	change(&updated)
+	updated.UpdatedAt = time.Now()
+	if err := o.save(&updated); err != nil {
+		return *e, err
+	}
+	*e = updated
+	return updated, nil
+}
+
+// claim marks the oldest entry due for delivery as sending and returns it.
+func (o *outbox) claim() (entry, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var due []*entry
+	now := time.Now()
+	for _, e := range o.entries {
+		if e.State == stateQueued && !e.NextAttempt.After(now) {
+			due = append(due, e)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].CreatedAt.Before(due[j].CreatedAt) })
+	for _, e := range due {
+		claimed := *e
+		claimed.State = stateSending
+		claimed.Attempts++
+		claimed.UpdatedAt = now
+		if err := o.save(&claimed); err != nil {
+			log.Printf("failed to claim outbox entry %s: %v", e.Id, err)
+			continue
+		}
+		*e = claimed
+		return claimed, true
+	}
+	return entry{}, false
+}
+
+// pending returns the ids of entries sent but not finished yet.
+func (o *outbox) pending() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var ids []string
+	for id, e := range o.entries {
+		if e.State == stateSent {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// prune removes finished entries, done, failed or unknown, last updated
+// before the given time and returns how many were removed. Their ids can
+// be used again afterwards.
+func (o *outbox) prune(before time.Time) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	removed := 0
+	for id, e := range o.entries {
+		if e.State != stateDone && e.State != stateFailed && e.State != stateUnknown || !e.UpdatedAt.Before(before) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(o.dir, id+".json")); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, fmt.Errorf("failed to remove outbox entry: %w", err)
+		}
+		delete(o.entries, id)
+		removed++
+	}
+	return removed, nil
+}
+
+// counts returns the number of entries in each state.
+func (o *outbox) counts() map[entryState]int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	counts := make(map[entryState]int)
+	for _, e := range o.entries {
+		counts[e.State]++
+	}
+	return counts
+}
+
+func (o *outbox) notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// save writes the entry atomically through a temporary file.
+func (o *outbox) save(e *entry) error {
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox entry: %w", err)
+	}
+	path := filepath.Join(o.dir, e.Id+".json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write outbox entry: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write outbox entry: %w", err)
+	}
+	return nil
+}
+
+// sameJSON reports whether a and b are the same JSON apart from
+// whitespace; stored documents are indented by save.
+func sameJSON(a, b []byte) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+func newId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validId reports whether id can be used as an outbox file name.
+func validId(id string) bool {
+	return id != "" && len(id) <= 128 && !strings.ContainsAny(id, `/\.`)
+}
+
+// pollInterval is how often waitFinal checks the status of a document.
+var pollInterval = 500 * time.Millisecond
+
+// deliverer sends outbox entries to the printers of the pool.
+type deliverer struct {
+	pool         *novitus.Pool
+	outbox       *outbox
+	attempts     int
+	finalTimeout time.Duration
+	wg           sync.WaitGroup
+}
+
+func (d *deliverer) start(ctx context.Context, workers int) {
+	for _, id := range d.outbox.pending() {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.waitFinal(ctx, id)
+		}()
+	}
+	for range max(workers, 1) {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.work(ctx)
+		}()
+	}
+}
+
+func (d *deliverer) wait() {
+	d.wg.Wait()
+}
+
+func (d *deliverer) work(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		for ctx.Err() == nil {
+			e, ok := d.outbox.claim()
+			if !ok {
+				break
+			}
+			d.deliver(ctx, e)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.outbox.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *deliverer) deliver(ctx context.Context, e entry) {
+	document, err := decodeDocument(e.Type, e.Document)
+	if err != nil {
+		d.fail(e.Id, err)
+		return
+	}
+	result, err := d.pool.SendContext(ctx, e.Key, e.Type, document, true)
+	switch {
+	case err == nil:
+		d.sent(ctx, e.Id, result.Printer, result.Status, "")
+	case result.Printer == "":
+		if e.Attempts >= d.attempts {
+			d.fail(e.Id, err)
+			return
+		}
+		// Nothing reached a printer, so the document can be sent again.
+		d.update(e.Id, func(e *entry) {
+			e.State = stateQueued
+			e.Error = err.Error()
+			e.NextAttempt = time.Now().Add(time.Duration(e.Attempts) * 5 * time.Second)
+		})
+	case result.Status.Request.Id == "":
+		// The send may have reached the printer without an answer.
+		d.update(e.Id, func(e *entry) {
+			e.State = stateUnknown
+			e.Printer = result.Printer
+			e.Error = fmt.Sprintf("%v; check printer %s before sending the document again", err, result.Printer)
+		})
+	default:
+		d.recoverConfirm(ctx, e, result, err)
+	}
+}
+
+// recoverConfirm handles a document stored on a printer whose confirm or
+// status check failed. A document still waiting for confirmation is
+// confirmed again or, if that fails too, deleted from the printer, so it
+// cannot be printed later by someone else's confirm.
+func (d *deliverer) recoverConfirm(ctx context.Context, e entry, result novitus.PoolResult, sendErr error) {
+	requestId := result.Status.Request.Id
+	client, ok := d.pool.Client(result.Printer)
+	if !ok {
+		d.update(e.Id, func(e *entry) {
+			e.State = stateUnknown
+			e.Printer = result.Printer
+			e.RequestId = requestId
+			e.Error = sendErr.Error()
+		})
+		return
+	}
+	status, err := client.CheckDocumentStatus(e.Type, requestId)
+	if err != nil || status.Status != novitus.RequestStatusStored {
+		// Confirmed or the status is unknown yet; waitFinal keeps polling.
+		d.sent(ctx, e.Id, result.Printer, result.Status, sendErr.Error())
+		return
+	}
+	_, err = client.Confirm(e.Type, requestId)
+	if err == nil {
+		d.sent(ctx, e.Id, result.Printer, status, fmt.Sprintf("confirmed again after: %v", sendErr))
+		return
+	}
+	sendErr = errors.Join(sendErr, err)
+	if _, err := client.DeleteDocument(e.Type, requestId); err != nil {
+		d.update(e.Id, func(e *entry) {
+			e.State = stateUnknown
+			e.Printer = result.Printer
+			e.RequestId = requestId
+			e.Status = &status
+			e.Error = fmt.Sprintf("request %s could not be confirmed nor deleted from printer %s: %v", requestId, result.Printer, errors.Join(sendErr, err))
+		})
+		return
+	}
+	d.update(e.Id, func(e *entry) {
+		e.State = stateFailed
+		e.Printer = result.Printer
+		e.RequestId = requestId
+		e.Status = &status
+		e.Error = fmt.Sprintf("request %s was deleted from printer %s because it could not be confirmed: %v", requestId, result.Printer, sendErr)
+	})
+}
+
+// sent marks the entry as confirmed on the printer and waits for its final
+// status in the background.
+func (d *deliverer) sent(ctx context.Context, id, printer string, status novitus.CheckDocumentStatusResponse, note string) {
+	if _, err := d.update(id, func(e *entry) {
+		e.State = stateSent
+		e.Printer = printer
+		e.RequestId = status.Request.Id
+		e.Status = &status
+		e.Error = note
+	}); err != nil {
+		return
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.waitFinal(ctx, id)
+	}()
+}
+
+// waitFinal polls the printer until the document is DONE or ERROR. Failed
+// polls are retried without touching the entry, which is only written when
+// its state changes; only an ERROR from the printer fails the entry. If no
+// final status arrives within finalTimeout, the entry is marked unknown
+// with the last poll error.
+func (d *deliverer) waitFinal(ctx context.Context, id string) {
+	e, ok := d.outbox.get(id)
+	if !ok {
+		return
+	}
+	client, ok := d.pool.Client(e.Printer)
+	if !ok {
+		d.update(id, func(e *entry) {
+			e.State = stateUnknown
+			e.Error = fmt.Sprintf("printer %s is not configured anymore, check it before sending the document again", e.Printer)
+		})
+		return
+	}
+	deadline := time.Now().Add(d.finalTimeout)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		status, err := client.CheckDocumentStatus(e.Type, e.RequestId)
+		switch {
+		case err != nil:
+			// Retried on the next tick.
+		case status.Status == novitus.RequestStatusDone:
+			d.update(id, func(e *entry) {
+				e.State = stateDone
+				e.Status = &status
+				e.Error = ""
+			})
+			return
+		case status.Status == novitus.RequestStatusError:
+			d.update(id, func(e *entry) {
+				e.State = stateFailed
+				e.Status = &status
+				e.Error = fmt.Sprintf("printer reported error %d: %s", status.Request.Error.Code, status.Request.Error.Description)
+			})
+			return
+		}
+		if !time.Now().Before(deadline) {
+			d.update(id, func(e *entry) {
+				e.State = stateUnknown
+				e.Error = fmt.Sprintf("no final status within %s, check printer %s before sending the document again", d.finalTimeout, e.Printer)
+				if err == nil {
+					e.Status = &status
+				} else {
+					e.Error += fmt.Sprintf(", last status check failed: %v", err)
+				}
+			})
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return // Shutting down, resumed on the next start
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *deliverer) fail(id string, err error) {
+	d.update(id, func(e *entry) {
+		e.State = stateFailed
+		e.Error = err.Error()
+	})
+}
+
+// update changes the entry and logs a failure to store the change.
+func (d *deliverer) update(id string, change func(e *entry)) (entry, error) {
+	e, err := d.outbox.update(id, change)
+	if err != nil {
+		log.Printf("failed to update outbox entry %s: %v", id, err)
+	}
+	return e, err
+}
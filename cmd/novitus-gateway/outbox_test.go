@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+)
+
+const receiptJSON = `{"items":[{"article":{"name":"a","ptu":"A","quantity":"1","price":"1.00","value":"1.00"}}],"summary":{"total":"1.00"}}`
+
+// fakePrinter stores documents like a Novitus printer. Confirmed documents
+// are reported as final after pollsToFinal status checks.
+type fakePrinter struct {
+	mu           sync.Mutex
+	next         int
+	statuses     map[string]string
+	polls        map[string]int
+	final        string // Final status of confirmed documents, none if empty
+	pollsToFinal int
+	failGets     int  // Number of status checks to answer with 500
+	failConfirm  bool // Answer every confirm with 500
+	failDelete   bool
+	calls        []string
+}
+
+func newFakePrinter(t *testing.T) (*fakePrinter, *httptest.Server) {
+	f := &fakePrinter{statuses: map[string]string{}, polls: map[string]int{}, final: novitus.RequestStatusDone}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakePrinter) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	fail := func() {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"boom"}}`)
+	}
+	switch {
+	case parts[0] == "token":
+		json.NewEncoder(w).Encode(map[string]string{"token": "t", "expiration_date": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	case parts[0] == "queue":
+		fmt.Fprint(w, `{"requests_in_queue":0}`)
+	case parts[0] == "device":
+		fmt.Fprint(w, `{"device":{"status":"OK"}}`)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		f.next++
+		id := fmt.Sprint("req", f.next)
+		f.statuses[id] = novitus.RequestStatusStored
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"STORED"}}`, id)
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		if f.failConfirm {
+			fail()
+			return
+		}
+		f.statuses[parts[1]] = novitus.RequestStatusConfirmed
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"CONFIRMED"}}`, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		if f.failGets > 0 {
+			f.failGets--
+			fail()
+			return
+		}
+		status := f.statuses[parts[1]]
+		if status == novitus.RequestStatusConfirmed && f.final != "" {
+			if f.polls[parts[1]]++; f.polls[parts[1]] > f.pollsToFinal {
+				status = f.final
+				f.statuses[parts[1]] = status
+			}
+		}
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":%q,"error":{"code":7,"description":"paper"}}}`, parts[1], status)
+	case len(parts) == 2 && r.Method == http.MethodDelete:
+		if f.failDelete {
+			fail()
+			return
+		}
+		delete(f.statuses, parts[1])
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"DELETED"}}`, parts[1])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"exception":{"code":404,"description":"unknown"}}`)
+	}
+}
+
+func (f *fakePrinter) set(change func(f *fakePrinter)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	change(f)
+}
+
+func (f *fakePrinter) count(call string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, c := range f.calls {
+		if c == call {
+			n++
+		}
+	}
+	return n
+}
+
+// newTestDeliverer returns a deliverer for a pool with the printer p1 at
+// host and an empty outbox.
+func newTestDeliverer(t *testing.T, host string) *deliverer {
+	interval := pollInterval
+	pollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { pollInterval = interval })
+	pool := novitus.NewPool(novitus.RetryPolicy{})
+	if _, err := pool.Add("p1", host, "", novitus.WithLazyToken()); err != nil {
+		t.Fatal(err)
+	}
+	box, err := openOutbox(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &deliverer{pool: pool, outbox: box, attempts: 2, finalTimeout: time.Second}
+}
+
+// deliverOne adds a receipt for p1, delivers it and waits for the final
+// state.
+func deliverOne(t *testing.T, d *deliverer) entry {
+	t.Helper()
+	e, _, err := d.outbox.add(&entry{Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimed, ok := d.outbox.claim()
+	if !ok {
+		t.Fatal("nothing to claim")
+	}
+	d.deliver(t.Context(), claimed)
+	d.wait()
+	e, _ = d.outbox.get(e.Id)
+	return e
+}
+
+func TestOutboxAdd(t *testing.T) {
+	dir := t.TempDir()
+	box, err := openOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, created, err := box.add(&entry{Id: "k1", Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+	if err != nil || !created || e.State != stateQueued {
+		t.Fatalf("add() = %+v, %v, %v", e, created, err)
+	}
+	// Stored documents are indented, a retry with the same document still
+	// matches after a restart.
+	box, err = openOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, created, err := box.add(&entry{Id: "k1", Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)}); err != nil || created {
+		t.Errorf("retry: created = %v, err = %v", created, err)
+	}
+	conflicts := []entry{
+		{Id: "k1", Key: "p2", Type: "receipt", Document: json.RawMessage(receiptJSON)},
+		{Id: "k1", Key: "p1", Type: "nf_printout", Document: json.RawMessage(receiptJSON)},
+		{Id: "k1", Key: "p1", Type: "receipt", Document: json.RawMessage(`{"items":[]}`)},
+	}
+	for _, c := range conflicts {
+		if _, _, err := box.add(&c); err != errIdConflict {
+			t.Errorf("add(%s, %s) = %v, want errIdConflict", c.Key, c.Type, err)
+		}
+	}
+}
+
+func TestOpenOutboxInterrupted(t *testing.T) {
+	dir := t.TempDir()
+	box, err := openOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, _, _ := box.add(&entry{Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+	if _, ok := box.claim(); !ok {
+		t.Fatal("nothing to claim")
+	}
+	box, err = openOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, _ = box.get(e.Id); e.State != stateUnknown || !strings.Contains(e.Error, "check the printer") {
+		t.Errorf("state = %s, error = %q", e.State, e.Error)
+	}
+	if _, ok := box.claim(); ok {
+		t.Error("interrupted entry claimed again")
+	}
+}
+
+func TestOutboxPrune(t *testing.T) {
+	dir := t.TempDir()
+	box, err := openOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range []entryState{stateQueued, stateSent, stateDone, stateFailed, stateUnknown} {
+		box.add(&entry{Id: string(state), Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+		box.update(string(state), func(e *entry) { e.State = state })
+	}
+	if removed, err := box.prune(time.Now().Add(-time.Hour)); err != nil || removed != 0 {
+		t.Fatalf("prune() of recent entries = %d, %v", removed, err)
+	}
+	if removed, err := box.prune(time.Now().Add(time.Second)); err != nil || removed != 3 {
+		t.Fatalf("prune() = %d, %v, want 3", removed, err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 2 {
+		t.Errorf("files = %v", files)
+	}
+	for _, id := range []string{"queued", "sent"} {
+		if _, ok := box.get(id); !ok {
+			t.Errorf("%s pruned", id)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "done.json")); !os.IsNotExist(err) {
+		t.Errorf("done.json not removed: %v", err)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	f, srv := newFakePrinter(t)
+	d := newTestDeliverer(t, srv.URL)
+	f.set(func(f *fakePrinter) { f.pollsToFinal = 2 })
+	e := deliverOne(t, d)
+	if e.State != stateDone || e.Printer != "p1" || e.RequestId != "req1" || e.Error != "" {
+		t.Errorf("entry = %+v", e)
+	}
+}
+
+func TestDeliverNotDelivered(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	host := srv.URL
+	srv.Close()
+	d := newTestDeliverer(t, host)
+	e, _, _ := d.outbox.add(&entry{Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+	claimed, _ := d.outbox.claim()
+	d.deliver(t.Context(), claimed)
+	if e, _ = d.outbox.get(e.Id); e.State != stateQueued || e.NextAttempt.IsZero() {
+		t.Fatalf("first attempt: entry = %+v", e)
+	}
+	d.outbox.update(e.Id, func(e *entry) { e.NextAttempt = time.Time{} })
+	claimed, _ = d.outbox.claim()
+	d.deliver(t.Context(), claimed)
+	if e, _ = d.outbox.get(e.Id); e.State != stateFailed || e.Attempts != 2 {
+		t.Errorf("last attempt: entry = %+v", e)
+	}
+}
+
+func TestDeliverConfirmFailed(t *testing.T) {
+	tests := []struct {
+		name       string
+		failDelete bool
+		want       entryState
+		wantError  string
+	}{
+		{"deleted", false, stateFailed, "request req1 was deleted from printer p1"},
+		{"not deleted", true, stateUnknown, "could not be confirmed nor deleted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, srv := newFakePrinter(t)
+			d := newTestDeliverer(t, srv.URL)
+			f.set(func(f *fakePrinter) {
+				f.failConfirm = true
+				f.failDelete = tt.failDelete
+			})
+			e := deliverOne(t, d)
+			if e.State != tt.want || e.RequestId != "req1" || !strings.Contains(e.Error, tt.wantError) {
+				t.Errorf("entry = %+v", e)
+			}
+			if n := f.count("PUT /api/v1/receipt/req1"); n != 2 {
+				t.Errorf("confirms = %d, want 2", n)
+			}
+		})
+	}
+}
+
+func TestDeliverConfirmedDespiteError(t *testing.T) {
+	f, srv := newFakePrinter(t)
+	d := newTestDeliverer(t, srv.URL)
+	// The status check after the confirm fails, the next one finds the
+	// document confirmed.
+	f.set(func(f *fakePrinter) { f.failGets = 1 })
+	e := deliverOne(t, d)
+	if e.State != stateDone {
+		t.Errorf("entry = %+v", e)
+	}
+	if n := f.count("PUT /api/v1/receipt/req1"); n != 1 {
+		t.Errorf("confirms = %d, want 1", n)
+	}
+	if n := f.count("DELETE /api/v1/receipt/req1"); n != 0 {
+		t.Errorf("deletes = %d, want 0", n)
+	}
+}
+
+func TestWaitFinal(t *testing.T) {
+	tests := []struct {
+		name      string
+		final     string
+		failGets  int
+		want      entryState
+		wantError string
+	}{
+		{"done after failed polls", novitus.RequestStatusDone, 3, stateDone, ""},
+		{"printer error", novitus.RequestStatusError, 0, stateFailed, "printer reported error 7: paper"},
+		{"timeout", "", 0, stateUnknown, "check printer p1 before sending the document again"},
+		{"unreachable until timeout", novitus.RequestStatusDone, 1000, stateUnknown, "last status check failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, srv := newFakePrinter(t)
+			d := newTestDeliverer(t, srv.URL)
+			d.finalTimeout = 200 * time.Millisecond
+			f.set(func(f *fakePrinter) {
+				f.statuses["req1"] = novitus.RequestStatusConfirmed
+				f.final = tt.final
+				f.failGets = tt.failGets
+			})
+			d.outbox.add(&entry{Id: "e1", Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+			d.outbox.update("e1", func(e *entry) {
+				e.State = stateSent
+				e.Printer = "p1"
+				e.RequestId = "req1"
+			})
+			d.waitFinal(t.Context(), "e1")
+			e, _ := d.outbox.get("e1")
+			if e.State != tt.want || !strings.Contains(e.Error, tt.wantError) {
+				t.Errorf("entry = %+v", e)
+			}
+		})
+	}
+}
+
+func TestWaitFinalShutdown(t *testing.T) {
+	f, srv := newFakePrinter(t)
+	d := newTestDeliverer(t, srv.URL)
+	f.set(func(f *fakePrinter) {
+		f.statuses["req1"] = novitus.RequestStatusConfirmed
+		f.final = ""
+		f.failGets = 2
+	})
+	d.outbox.add(&entry{Id: "e1", Key: "p1", Type: "receipt", Document: json.RawMessage(receiptJSON)})
+	sent, _ := d.outbox.update("e1", func(e *entry) {
+		e.State = stateSent
+		e.Printer = "p1"
+		e.RequestId = "req1"
+	})
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	d.waitFinal(ctx, "e1")
+	e, _ := d.outbox.get("e1")
+	if e.State != stateSent {
+		t.Errorf("state = %s, want sent to resume on the next start", e.State)
+	}
+	if !e.UpdatedAt.Equal(sent.UpdatedAt) || e.Error != "" {
+		t.Errorf("failed polls rewrote the entry: %+v", e)
+	}
+}
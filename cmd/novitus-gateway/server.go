@@ -0,0 +1,242 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+)
+
+const maxDocumentSize = 1 << 20
+
+type server struct {
+	pool   *novitus.Pool
+	outbox *outbox
+	token  string // Bearer token required by every endpoint but /healthz, none if empty
+}
+
+func newServer(pool *novitus.Pool, box *outbox, token string) *server {
+	return &server{pool: pool, outbox: box, token: token}
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/documents/{type}", s.authorize(s.handleSend))
+	mux.HandleFunc("GET /v1/documents/{id}", s.authorize(s.handleGet))
+	mux.HandleFunc("GET /v1/printers", s.authorize(s.handlePrinters))
+	mux.HandleFunc("GET /healthz", s.handleHealthz)
+	mux.HandleFunc("GET /readyz", s.authorize(s.handleReadyz))
+	return mux
+}
+
+// authorize rejects requests without the bearer token of the server.
+func (s *server) authorize(handler http.HandlerFunc) http.HandlerFunc {
+	if s.token == "" {
+		return handler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "missing or invalid bearer token")
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// documentResponse is the public view of an outbox entry.
+type documentResponse struct {
+	Id        string                               `json:"id"`
+	Key       string                               `json:"key"`
+	Type      string                               `json:"type"`
+	State     entryState                           `json:"state"`
+	Printer   string                               `json:"printer,omitempty"`
+	RequestId string                               `json:"request_id,omitempty"`
+	Status    *novitus.CheckDocumentStatusResponse `json:"status,omitempty"`
+	Error     string                               `json:"error,omitempty"`
+	Attempts  int                                  `json:"attempts"`
+	CreatedAt time.Time                            `json:"created_at"`
+	UpdatedAt time.Time                            `json:"updated_at"`
+}
+
+func newDocumentResponse(e entry) documentResponse {
+	return documentResponse{
+		Id:        e.Id,
+		Key:       e.Key,
+		Type:      e.Type,
+		State:     e.State,
+		Printer:   e.Printer,
+		RequestId: e.RequestId,
+		Status:    e.Status,
+		Error:     e.Error,
+		Attempts:  e.Attempts,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+// handleSend validates the document and queues it for delivery. The
+// Idempotency-Key header, if set, is used as the document id, so a retried
+// request does not print the document twice; reusing it for a different
+// document is a conflict.
+func (s *server) handleSend(w http.ResponseWriter, r *http.Request) {
+	documentType := r.PathValue("type")
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		writeError(w, http.StatusBadRequest, "key query parameter is required")
+		return
+	}
+	if _, _, err := s.pool.Resolve(key); err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	id := r.Header.Get("Idempotency-Key")
+	if id != "" && !validId(id) {
+		writeError(w, http.StatusBadRequest, "Idempotency-Key may not contain '/', '\\' or '.' and must be at most 128 characters")
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDocumentSize))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "document is too large")
+		return
+	}
+	document, err := decodeDocument(documentType, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := document.Validate(); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation error: "+err.Error())
+		return
+	}
+	e, created, err := s.outbox.add(&entry{Id: id, Key: key, Type: documentType, Document: body})
+	if errors.Is(err, errIdConflict) {
+		writeError(w, http.StatusConflict, "Idempotency-Key "+err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	status := http.StatusAccepted
+	if !created {
+		status = http.StatusOK
+	}
+	w.Header().Set("Location", "/v1/documents/"+e.Id)
+	writeJSON(w, status, newDocumentResponse(e))
+}
+
+func (s *server) handleGet(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.outbox.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "document not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, newDocumentResponse(e))
+}
+
+type printerResponse struct {
+	Name      string               `json:"name"`
+	Healthy   bool                 `json:"healthy"`
+	Errors    int                  `json:"errors,omitempty"`
+	LastError string               `json:"last_error,omitempty"`
+	Circuit   novitus.CircuitState `json:"circuit"`
+	Device    *deviceResponse      `json:"device,omitempty"`
+}
+
+type deviceResponse struct {
+	Status    string             `json:"status,omitempty"`
+	CheckedAt time.Time          `json:"checked_at"`
+	Error     string             `json:"error,omitempty"`
+	Problems  []string           `json:"problems,omitempty"`
+	Info      novitus.DeviceInfo `json:"info"`
+}
+
+func (s *server) printers() []printerResponse {
+	health := s.pool.Health()
+	var printers []printerResponse
+	for _, name := range s.pool.Names() {
+		printer := printerResponse{Name: name, Healthy: true}
+		if status, ok := s.pool.Status(name); ok {
+			printer.Healthy = status.Healthy
+			printer.Errors = status.Errors
+			if status.LastError != nil {
+				printer.LastError = status.LastError.Error()
+			}
+		}
+		if client, ok := s.pool.Client(name); ok {
+			printer.Circuit = client.CircuitState()
+		}
+		if device, ok := health[name]; ok {
+			printer.Device = &deviceResponse{Status: device.Status, CheckedAt: device.CheckedAt, Problems: device.Info.Problems(), Info: device.Info}
+			if device.Err != nil {
+				printer.Device.Error = device.Err.Error()
+			}
+		}
+		printers = append(printers, printer)
+	}
+	return printers
+}
+
+func (s *server) handlePrinters(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("check") == "true" {
+		s.pool.CheckHealth()
+	}
+	writeJSON(w, http.StatusOK, s.printers())
+}
+
+// handleHealthz reports that the gateway process is up.
+func (s *server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"status": "ok", "outbox": s.outbox.counts()})
+}
+
+// handleReadyz reports whether at least one printer can take documents.
+func (s *server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	printers := s.printers()
+	for _, printer := range printers {
+		if printer.Healthy && printer.Circuit != novitus.CircuitOpen {
+			writeJSON(w, http.StatusOK, map[string]any{"status": "ready", "printers": printers})
+			return
+		}
+	}
+	writeJSON(w, http.StatusServiceUnavailable, map[string]any{"status": "no healthy printer", "printers": printers})
+}
+
+// decodeDocument decodes a document of the given object type from its
+// JSON form in the Novitus API format.
+func decodeDocument(documentType string, data []byte) (novitus.Document, error) {
+	var document novitus.Document
+	switch documentType {
+	case "receipt":
+		document = &novitus.Receipt{}
+	case "invoice":
+		document = &novitus.Invoice{}
+	case "nf_printout":
+		document = &novitus.Printout{}
+	case "return":
+		document = &novitus.ReturnDocument{}
+	default:
+		return nil, fmt.Errorf("document type must be one of: receipt, invoice, nf_printout, return")
+	}
+	if err := json.Unmarshal(data, document); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", documentType, err)
+	}
+	return document, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthorize(t *testing.T) {
+	_, printer := newFakePrinter(t)
+	d := newTestDeliverer(t, printer.URL)
+	srv := httptest.NewServer(newServer(d.pool, d.outbox, "secret").routes())
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		want          int
+	}{
+		{"no token", "/v1/printers", "", http.StatusUnauthorized},
+		{"wrong token", "/v1/printers", "Bearer other", http.StatusUnauthorized},
+		{"not bearer", "/v1/printers", "secret", http.StatusUnauthorized},
+		{"token", "/v1/printers", "Bearer secret", http.StatusOK},
+		{"document", "/v1/documents/k1", "", http.StatusUnauthorized},
+		{"readyz", "/readyz", "", http.StatusUnauthorized},
+		{"healthz", "/healthz", "", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, srv.URL+tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if res.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleSend(t *testing.T) {
+	_, printer := newFakePrinter(t)
+	d := newTestDeliverer(t, printer.URL)
+	srv := httptest.NewServer(newServer(d.pool, d.outbox, "").routes())
+	t.Cleanup(srv.Close)
+
+	send := func(path, idempotencyKey, body string) (int, documentResponse) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(body))
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		var document documentResponse
+		json.NewDecoder(res.Body).Decode(&document)
+		return res.StatusCode, document
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		idempotencyKey string
+		body           string
+		want           int
+	}{
+		{"queued", "/v1/documents/receipt?key=p1", "k1", receiptJSON, http.StatusAccepted},
+		{"retry", "/v1/documents/receipt?key=p1", "k1", receiptJSON, http.StatusOK},
+		{"other document", "/v1/documents/receipt?key=p1", "k1", `{"items":[{"article":{"name":"b","ptu":"A","quantity":"1","price":"2.00","value":"2.00"}}],"summary":{"total":"2.00"}}`, http.StatusConflict},
+		{"no key", "/v1/documents/receipt", "", receiptJSON, http.StatusBadRequest},
+		{"unknown key", "/v1/documents/receipt?key=p9", "", receiptJSON, http.StatusNotFound},
+		{"invalid id", "/v1/documents/receipt?key=p1", "../k", receiptJSON, http.StatusBadRequest},
+		{"unknown type", "/v1/documents/order?key=p1", "", receiptJSON, http.StatusBadRequest},
+		{"invalid document", "/v1/documents/receipt?key=p1", "", `{"items":[]}`, http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, document := send(tt.path, tt.idempotencyKey, tt.body)
+			if status != tt.want {
+				t.Fatalf("status = %d, want %d", status, tt.want)
+			}
+			if status < 300 && (document.Id != tt.idempotencyKey || document.State != stateQueued) {
+				t.Errorf("document = %+v", document)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Command novitusctl talks to a Novitus fiscal printer from the shell.
+//
+// Usage:
+//
+//	novitusctl [-host URL] [-token TOKEN] [-o json|table] <command> [arguments]
+//
+// The host and token default to the NOVITUS_HOST and NOVITUS_TOKEN
+// environment variables. Run novitusctl -h for the list of commands.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+)
+
+const usage = `Usage: novitusctl [flags] <command> [arguments]
+
+Commands:
+  token [refresh]                       obtain a new token, or refresh -token
+  queue status                          show the number of requests in queue
+  queue clear                           delete all requests from the queue
+  send [-confirm] receipt|invoice|printout FILE
+                                        send a document from a JSON file ("-" for stdin)
+  confirm TYPE ID                       confirm a sent document
+  status TYPE ID                        show the status of a document
+  delete TYPE ID                        delete a document
+  wait [-interval D] [-timeout D] TYPE ID
+                                        wait until a document is DONE or ERROR
+  device                                show device information
+
+TYPE is the object type, e.g. receipt, invoice or nf_printout.
+
+Flags:
+`
+
+type command struct {
+	args  int // Number of positional arguments
+	flags func(fs *flag.FlagSet) func(client *novitus.NovitusClient, args []string) (any, error)
+}
+
+var commands = map[string]command{
+	"queue status": {0, plain(func(client *novitus.NovitusClient, _ []string) (any, error) {
+		return client.GetQueueStatus()
+	})},
+	"queue clear": {0, plain(func(client *novitus.NovitusClient, _ []string) (any, error) {
+		return client.DeleteQueue()
+	})},
+	"send": {2, func(fs *flag.FlagSet) func(*novitus.NovitusClient, []string) (any, error) {
+		confirm := fs.Bool("confirm", false, "confirm the document after sending it")
+		return func(client *novitus.NovitusClient, args []string) (any, error) {
+			return send(client, args[0], args[1], *confirm)
+		}
+	}},
+	"confirm": {2, plain(func(client *novitus.NovitusClient, args []string) (any, error) {
+		return client.Confirm(args[0], args[1])
+	})},
+	"status": {2, plain(func(client *novitus.NovitusClient, args []string) (any, error) {
+		return client.CheckDocumentStatus(args[0], args[1])
+	})},
+	"delete": {2, plain(func(client *novitus.NovitusClient, args []string) (any, error) {
+		return client.DeleteDocument(args[0], args[1])
+	})},
+	"wait": {2, func(fs *flag.FlagSet) func(*novitus.NovitusClient, []string) (any, error) {
+		interval := fs.Duration("interval", 500*time.Millisecond, "status polling interval")
+		timeout := fs.Duration("timeout", time.Minute, "how long to wait")
+		return func(client *novitus.NovitusClient, args []string) (any, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+			defer cancel()
+			return client.WaitForDocument(ctx, args[0], args[1], *interval)
+		}
+	}},
+	"device": {0, plain(func(client *novitus.NovitusClient, _ []string) (any, error) {
+		return client.GetDeviceInfo()
+	})},
+}
+
+func plain(run func(*novitus.NovitusClient, []string) (any, error)) func(*flag.FlagSet) func(*novitus.NovitusClient, []string) (any, error) {
+	return func(*flag.FlagSet) func(*novitus.NovitusClient, []string) (any, error) {
+		return run
+	}
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil && !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stderr, "novitusctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("novitusctl", flag.ContinueOnError)
+	host := fs.String("host", os.Getenv("NOVITUS_HOST"), "Novitus API base URL, e.g. http://10.0.0.5:8888 (env NOVITUS_HOST)")
+	token := fs.String("token", os.Getenv("NOVITUS_TOKEN"), "bearer token; a new one is obtained if empty (env NOVITUS_TOKEN)")
+	output := fs.String("o", "table", "output format: json or table")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "json" && *output != "table" {
+		return fmt.Errorf("output must be one of: json, table")
+	}
+	if *host == "" {
+		return fmt.Errorf("host is required, use -host or NOVITUS_HOST")
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return errors.New("command is required")
+	}
+
+	var result any
+	var err error
+	if args[0] == "token" {
+		result, err = runToken(*host, *token, args[1:])
+	} else {
+		result, err = runCommand(*host, *token, args)
+	}
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	return writeTable(out, result)
+}
+
+func runToken(host, token string, args []string) (any, error) {
+	switch {
+	case len(args) == 0:
+		client, err := novitus.NewNovitusClient(host, "")
+		if err != nil {
+			return nil, err
+		}
+		return tokenResult(client), nil
+	case len(args) == 1 && args[0] == "refresh":
+		if token == "" {
+			return nil, fmt.Errorf("token refresh needs -token or NOVITUS_TOKEN")
+		}
+		client, err := novitus.NewNovitusClient(host, token)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.RefreshToken(); err != nil {
+			return nil, err
+		}
+		return tokenResult(client), nil
+	}
+	return nil, fmt.Errorf("usage: token [refresh]")
+}
+
+func tokenResult(client *novitus.NovitusClient) novitus.TokenResponse {
+	token, expiration := client.Token()
+	return novitus.TokenResponse{Token: token, ExpirationDate: expiration.Format(time.RFC3339)}
+}
+
+func runCommand(host, token string, args []string) (any, error) {
+	name := args[0]
+	if name == "queue" && len(args) > 1 {
+		name = "queue " + args[1]
+		args = args[1:]
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %q, run novitusctl -h for help", strings.Join(args[:1], " "))
+	}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	run := cmd.flags(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != cmd.args {
+		return nil, fmt.Errorf("%s needs %d arguments, got %d", name, cmd.args, fs.NArg())
+	}
+	client, err := novitus.NewNovitusClient(host, token)
+	if err != nil {
+		return nil, err
+	}
+	return run(client, fs.Args())
+}
+
+func send(client *novitus.NovitusClient, kind, path string, confirm bool) (any, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	switch kind {
+	case "receipt":
+		var receipt novitus.Receipt
+		if err := json.Unmarshal(data, &receipt); err != nil {
+			return nil, fmt.Errorf("failed to parse receipt: %w", err)
+		}
+		return client.SendReceipt(&receipt, confirm)
+	case "invoice":
+		var invoice novitus.Invoice
+		if err := json.Unmarshal(data, &invoice); err != nil {
+			return nil, fmt.Errorf("failed to parse invoice: %w", err)
+		}
+		return client.SendInvoice(&invoice, confirm)
+	case "printout":
+		var printout novitus.Printout
+		if err := json.Unmarshal(data, &printout); err != nil {
+			return nil, fmt.Errorf("failed to parse printout: %w", err)
+		}
+		return client.SendNFPrintout(&printout, confirm)
+	}
+	return nil, fmt.Errorf("document type must be one of: receipt, invoice, printout")
+}
+
+// writeTable writes the result as FIELD/VALUE rows, with nested fields
+// flattened to dotted names and empty strings and nulls left out.
+func writeTable(out io.Writer, result any) error {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return err
+	}
+	rows := make(map[string]string)
+	flatten("", value, rows)
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tVALUE")
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\n", key, rows[key])
+	}
+	return w.Flush()
+}
+
+func flatten(prefix string, value any, rows map[string]string) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			flatten(join(key), item, rows)
+		}
+	case []any:
+		for idx, item := range v {
+			flatten(join(fmt.Sprint(idx)), item, rows)
+		}
+	case nil:
+	default:
+		if text := fmt.Sprint(v); text != "" {
+			rows[prefix] = text
+		}
+	}
+}
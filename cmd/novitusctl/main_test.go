@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/token":
+			json.NewEncoder(w).Encode(map[string]string{"token": "new", "expiration_date": time.Now().Add(time.Hour).Format(time.RFC3339)})
+		case r.URL.Path == "/api/v1/queue":
+			fmt.Fprint(w, `{"requests_in_queue":3}`)
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"request":{"id":"r1","status":"STORED"}}`)
+		case r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"device":{"status":"OK"},"request":{"id":"r1","status":"STORED","jpkid":0}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":{"description":"unknown"}}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRun(t *testing.T) {
+	srv := newServer(t)
+	receipt := filepath.Join(t.TempDir(), "receipt.json")
+	os.WriteFile(receipt, []byte(`{"items":[{"article":{"name":"a","ptu":"A","quantity":"1","price":"1.00","value":"1.00"}}],"summary":{"total":"1.00"}}`), 0o644)
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"queue table", []string{"-host", srv.URL, "-token", "t", "queue", "status"}, "FIELD              VALUE\nrequests_in_queue  3\n", ""},
+		{"queue json", []string{"-host", srv.URL, "-token", "t", "-o", "json", "queue", "status"}, "{\n  \"requests_in_queue\": 3\n}\n", ""},
+		{"send", []string{"-host", srv.URL, "-token", "t", "send", "receipt", receipt}, "request.id", ""},
+		{"token", []string{"-host", srv.URL, "-o", "json", "token"}, `"token": "new"`, ""},
+		{"no host", []string{"-token", "t", "device"}, "", "host is required"},
+		{"bad output", []string{"-host", srv.URL, "-o", "xml", "device"}, "", "output must be one of"},
+		{"no command", []string{"-host", srv.URL}, "", "command is required"},
+		{"unknown command", []string{"-host", srv.URL, "print"}, "", `unknown command "print"`},
+		{"missing arguments", []string{"-host", srv.URL, "status", "receipt"}, "", "status needs 2 arguments, got 1"},
+		{"refresh without token", []string{"-host", srv.URL, "token", "refresh"}, "", "needs -token"},
+		{"unknown document type", []string{"-host", srv.URL, "-token", "t", "send", "order", receipt}, "", "document type must be one of"},
+		{"invalid document", []string{"-host", srv.URL, "-token", "t", "send", "invoice", receipt}, "", "Validation Error"},
+		{"zero interval", []string{"-host", srv.URL, "-token", "t", "wait", "-interval", "0", "receipt", "r1"}, "", "interval must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NOVITUS_HOST", "")
+			t.Setenv("NOVITUS_TOKEN", "")
+			var out bytes.Buffer
+			err := run(tt.args, &out)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("run() = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("output %q does not contain %q", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	rows := make(map[string]string)
+	flatten("", map[string]any{"a": map[string]any{"b": "x", "c": nil, "d": ""}, "e": []any{1.0, true}}, rows)
+	want := map[string]string{"a.b": "x", "e.0": "1", "e.1": "true"}
+	if fmt.Sprint(rows) != fmt.Sprint(want) {
+		t.Errorf("flatten() = %v, want %v", rows, want)
+	}
+}
@@ -0,0 +1,104 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"time"
+)
+
+type FiscalState string
+
+const (
+	FiscalStateNonFiscal FiscalState = "non_fiscal" // Training mode, before fiscalization
+	FiscalStateFiscal    FiscalState = "fiscal"
+	FiscalStateReadOnly  FiscalState = "read_only" // Fiscal memory full or closed, sales are blocked
+)
+
+type PaperState string
+
+const (
+	PaperOk      PaperState = "ok"
+	PaperNearEnd PaperState = "near_end"
+	PaperOut     PaperState = "out"
+)
+
+type DeviceInfo struct {
+	Model        string      `json:"model"`
+	Firmware     string      `json:"firmware"`
+	SerialNumber string      `json:"serial_number"`
+	UniqueNumber string      `json:"unique_number"` // Unique number of the fiscal printer
+	FiscalState  FiscalState `json:"fiscal_state"`  // Enum: "non_fiscal" "fiscal" "read_only"
+	Paper        PaperState  `json:"paper"`         // Enum: "ok" "near_end" "out"
+	CoverOpen    bool        `json:"cover_open"`
+	Clock        string      `json:"clock"` // Printer time, e.g. "2025-01-31T12:00:00+01:00"
+	Status       string      `json:"status"`
+	Error        `json:"error"`
+}
+
+type DeviceInfoResponse struct {
+	Device DeviceInfo `json:"device"`
+}
+
+func (d DeviceInfo) ClockTime() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, d.Clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse device clock: %w", err)
+	}
+	return t, nil
+}
+
+// Problems lists the reasons the printer cannot print a sale right now.
+func (d DeviceInfo) Problems() []string {
+	var problems []string
+	if d.Paper == PaperOut {
+		problems = append(problems, "out of paper")
+	}
+	if d.CoverOpen {
+		problems = append(problems, "cover open")
+	}
+	if d.FiscalState == FiscalStateReadOnly {
+		problems = append(problems, "fiscal memory in read-only mode")
+	}
+	if d.Error.Code != 0 {
+		problems = append(problems, fmt.Sprintf("device error %d: %s", d.Error.Code, d.Error.Description))
+	}
+	return problems
+}
+
+// CanPrint reports whether the printer is ready to print a sale.
+func (d DeviceInfo) CanPrint() bool {
+	return len(d.Problems()) == 0
+}
+
+// DeviceHealth is the last known state of the printer, as cached by the
+// client.
+type DeviceHealth struct {
+	Info      DeviceInfo // Zero if the device was never queried successfully
+	Status    string     // Last device status reported with a document status
+	CheckedAt time.Time
+	Err       error // Error of the last device query, cleared by a successful query or status check
+}
+
+// Healthy reports whether the last check succeeded and found no problems.
+// It is false while the device status or the fiscal state is unknown,
+// e.g. before the first successful GetDeviceInfo.
+func (h DeviceHealth) Healthy() bool {
+	if h.CheckedAt.IsZero() || h.Err != nil || h.Status == "" || h.Info.FiscalState == "" {
+		return false
+	}
+	return h.Info.CanPrint()
+}
+
+// Health returns the cached device health, updated by GetDeviceInfo and
+// by every document status check. ok is false if nothing is known yet.
+func (n *NovitusClient) Health() (health DeviceHealth, ok bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.health, !n.health.CheckedAt.IsZero()
+}
+
+func (n *NovitusClient) updateHealth(update func(health *DeviceHealth)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	update(&n.health)
+	n.health.CheckedAt = time.Now()
+}
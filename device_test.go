@@ -0,0 +1,103 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeviceInfoProblems(t *testing.T) {
+	tests := []struct {
+		info DeviceInfo
+		want []string
+	}{
+		{DeviceInfo{Paper: PaperOk, FiscalState: FiscalStateFiscal}, nil},
+		{DeviceInfo{Paper: PaperNearEnd}, nil},
+		{DeviceInfo{Paper: PaperOut, CoverOpen: true}, []string{"out of paper", "cover open"}},
+		{DeviceInfo{FiscalState: FiscalStateReadOnly}, []string{"fiscal memory in read-only mode"}},
+		{DeviceInfo{Error: Error{Code: 5, Description: "jam"}}, []string{"device error 5: jam"}},
+	}
+	for _, tt := range tests {
+		got := tt.info.Problems()
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") || tt.info.CanPrint() != (len(tt.want) == 0) {
+			t.Errorf("%+v: Problems() = %q, want %q", tt.info, got, tt.want)
+		}
+	}
+}
+
+func TestDeviceInfoClockTime(t *testing.T) {
+	clock, err := DeviceInfo{Clock: "2025-01-31T12:00:00+01:00"}.ClockTime()
+	if err != nil || clock.UTC().Hour() != 11 {
+		t.Errorf("ClockTime() = %v, %v", clock, err)
+	}
+	if _, err := (DeviceInfo{Clock: "31.01.2025"}).ClockTime(); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestHealth(t *testing.T) {
+	client, f := newFakeClient(t)
+	if _, ok := client.Health(); ok {
+		t.Fatal("health is known before any check")
+	}
+	device := `{"device":{"status":"OK","paper":"out"}}`
+	f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+		if r.URL.Path != "/api/v1/device" {
+			return false
+		}
+		fmt.Fprint(w, device)
+		return true
+	})
+	if _, err := client.GetDeviceInfo(); err != nil {
+		t.Fatal(err)
+	}
+	health, ok := client.Health()
+	if !ok || health.Healthy() || health.Info.Paper != PaperOut {
+		t.Errorf("health = %+v", health)
+	}
+
+	f.setHandle(nil)
+	f.mu.Lock()
+	f.fail = http.StatusInternalServerError
+	f.mu.Unlock()
+	if _, err := client.GetDeviceInfo(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if health, _ := client.Health(); health.Err == nil || health.Info.Paper != PaperOut {
+		t.Errorf("health after a failed query = %+v", health)
+	}
+
+	f.mu.Lock()
+	f.fail = 0
+	f.statuses["r1"] = RequestStatusDone
+	f.mu.Unlock()
+	if _, err := client.CheckDocumentStatus("receipt", "r1"); err != nil {
+		t.Fatal(err)
+	}
+	if health, _ := client.Health(); health.Err != nil || health.Status != "OK" {
+		t.Errorf("health after a successful status check = %+v", health)
+	}
+}
+
+func TestDeviceHealthHealthy(t *testing.T) {
+	ready := DeviceInfo{FiscalState: FiscalStateFiscal, Paper: PaperOk, Status: "OK"}
+	tests := []struct {
+		name   string
+		health DeviceHealth
+		want   bool
+	}{
+		{"zero", DeviceHealth{}, false},
+		{"only a status check", DeviceHealth{Status: "OK", CheckedAt: time.Now()}, false},
+		{"no status", DeviceHealth{Info: ready, CheckedAt: time.Now()}, false},
+		{"ready", DeviceHealth{Info: ready, Status: "OK", CheckedAt: time.Now()}, true},
+		{"query failed", DeviceHealth{Info: ready, Status: "OK", CheckedAt: time.Now(), Err: errors.New("timeout")}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.health.Healthy(); got != tt.want {
+			t.Errorf("%s: Healthy() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
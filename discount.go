@@ -0,0 +1,122 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+type DiscountMarkupType string
+
+const (
+	PercentDiscount DiscountMarkupType = "percent_discount"
+	PercentMarkup   DiscountMarkupType = "percent_markup"
+	ValueDiscount   DiscountMarkupType = "value_discount"
+	ValueMarkup     DiscountMarkupType = "value_markup"
+)
+
+func (t DiscountMarkupType) IsPercent() bool {
+	return t == PercentDiscount || t == PercentMarkup
+}
+
+func (t DiscountMarkupType) IsDiscount() bool {
+	return t == PercentDiscount || t == ValueDiscount
+}
+
+func (d *DiscountMarkup) Validate() error {
+	if d.Type != PercentDiscount && d.Type != PercentMarkup && d.Type != ValueDiscount && d.Type != ValueMarkup {
+		return fmt.Errorf("type must be one of: percent_discount, percent_markup, value_discount, value_markup")
+	}
+	value, err := decimal.NewFromString(d.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value format: %w", err)
+	}
+	if !value.IsPositive() {
+		return fmt.Errorf("value must be greater than 0")
+	}
+	if !value.Equal(value.Round(2)) {
+		return fmt.Errorf("value must have at most 2 decimal places")
+	}
+	if d.Type.IsPercent() && value.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("percent value must be between 0 and 100")
+	}
+	return nil
+}
+
+// ValidateFor validates the discount or markup against the value it is
+// applied to. A discount may not exceed that value.
+func (d *DiscountMarkup) ValidateFor(base decimal.Decimal) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	if d.Type == ValueDiscount {
+		value, _ := decimal.NewFromString(d.Value)
+		if value.GreaterThan(base) {
+			return fmt.Errorf("discount %s exceeds value %s", value.StringFixed(2), base.StringFixed(2))
+		}
+	}
+	return nil
+}
+
+// Amount returns the signed change the discount or markup makes to base:
+// negative for discounts, positive for markups. Percent amounts are
+// rounded half up to grosze, as on the printout.
+func (d *DiscountMarkup) Amount(base decimal.Decimal) (decimal.Decimal, error) {
+	if err := d.ValidateFor(base); err != nil {
+		return decimal.Zero, fmt.Errorf("discount_markup: %w", err)
+	}
+	value, _ := decimal.NewFromString(d.Value)
+	amount := value
+	if d.Type.IsPercent() {
+		amount = base.Mul(value).Div(decimal.NewFromInt(100)).Round(2)
+	}
+	if d.Type.IsDiscount() {
+		return amount.Neg(), nil
+	}
+	return amount, nil
+}
+
+// Apply returns base after the discount or markup.
+func (d *DiscountMarkup) Apply(base decimal.Decimal) (decimal.Decimal, error) {
+	amount, err := d.Amount(base)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return base.Add(amount), nil
+}
+
+// DistributeDiscountMarkup applies a summary level discount or markup to
+// values grouped by PTU letter. The amount is calculated from the total of
+// all groups and split between them in proportion to their values; the
+// rounding residue goes to the largest group, so the groups always add up
+// to the discounted total.
+func DistributeDiscountMarkup(d *DiscountMarkup, groups map[string]decimal.Decimal) (map[string]decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, value := range groups {
+		total = total.Add(value)
+	}
+	amount, err := d.Amount(total)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]decimal.Decimal, len(groups))
+	for key, value := range groups {
+		result[key] = value
+	}
+	if total.IsZero() {
+		return result, nil
+	}
+	keys := sortedPTUs(groups)
+	largest := keys[0]
+	assigned := decimal.Zero
+	for _, key := range keys {
+		share := amount.Mul(groups[key]).Div(total).Round(2)
+		result[key] = result[key].Add(share)
+		assigned = assigned.Add(share)
+		if groups[key].GreaterThan(groups[largest]) {
+			largest = key
+		}
+	}
+	result[largest] = result[largest].Add(amount.Sub(assigned))
+	return result, nil
+}
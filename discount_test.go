@@ -0,0 +1,141 @@
+package novitus_gosdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDiscountMarkupValidate(t *testing.T) {
+	tests := []struct {
+		discount DiscountMarkup
+		err      string
+	}{
+		{DiscountMarkup{Type: PercentDiscount, Value: "10"}, ""},
+		{DiscountMarkup{Type: ValueMarkup, Value: "0.01"}, ""},
+		{DiscountMarkup{Type: PercentMarkup, Value: "100"}, ""},
+		{DiscountMarkup{Type: "discount", Value: "10"}, "type must be one of"},
+		{DiscountMarkup{Type: ValueDiscount, Value: "abc"}, "invalid value format"},
+		{DiscountMarkup{Type: ValueDiscount, Value: "0"}, "greater than 0"},
+		{DiscountMarkup{Type: ValueDiscount, Value: "-1"}, "greater than 0"},
+		{DiscountMarkup{Type: ValueDiscount, Value: "1.001"}, "at most 2 decimal places"},
+		{DiscountMarkup{Type: PercentDiscount, Value: "100.01"}, "between 0 and 100"},
+	}
+	for _, tt := range tests {
+		err := tt.discount.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%+v: unexpected error %v", tt.discount, err)
+		}
+		if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%+v: got %v, want %q", tt.discount, err, tt.err)
+		}
+	}
+}
+
+func TestDiscountMarkupApply(t *testing.T) {
+	tests := []struct {
+		discount DiscountMarkup
+		base     string
+		want     string
+	}{
+		{DiscountMarkup{Type: PercentDiscount, Value: "10"}, "19.99", "17.99"},
+		{DiscountMarkup{Type: PercentDiscount, Value: "15"}, "0.10", "0.08"},
+		{DiscountMarkup{Type: PercentMarkup, Value: "5"}, "10.10", "10.61"},
+		{DiscountMarkup{Type: ValueDiscount, Value: "5.00"}, "5.00", "0.00"},
+		{DiscountMarkup{Type: ValueMarkup, Value: "1.50"}, "3.00", "4.50"},
+	}
+	for _, tt := range tests {
+		got, err := tt.discount.Apply(decimal.RequireFromString(tt.base))
+		if err != nil {
+			t.Fatalf("%+v: %v", tt.discount, err)
+		}
+		if got.StringFixed(2) != tt.want {
+			t.Errorf("%+v on %s = %s, want %s", tt.discount, tt.base, got.StringFixed(2), tt.want)
+		}
+	}
+}
+
+func TestDiscountExceedingValue(t *testing.T) {
+	discount := DiscountMarkup{Type: ValueDiscount, Value: "5.01"}
+	if _, err := discount.Apply(decimal.RequireFromString("5.00")); err == nil {
+		t.Error("expected an error for a discount above the value")
+	}
+}
+
+func TestDistributeDiscountMarkup(t *testing.T) {
+	discount := &DiscountMarkup{Type: PercentDiscount, Value: "10"}
+	groups, err := DistributeDiscountMarkup(discount, map[string]decimal.Decimal{
+		"A": decimal.RequireFromString("3.33"),
+		"B": decimal.RequireFromString("3.33"),
+		"C": decimal.RequireFromString("3.34"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := decimal.Zero
+	for ptu, value := range groups {
+		if value.StringFixed(2) != "3.00" {
+			t.Errorf("group %s = %s, want 3.00", ptu, value.StringFixed(2))
+		}
+		total = total.Add(value)
+	}
+	if total.StringFixed(2) != "9.00" {
+		t.Errorf("total = %s, want 9.00", total)
+	}
+}
+
+func TestDocumentValidatesArticleDiscount(t *testing.T) {
+	item := map[string]interface{}{"article": Article{Name: "a", PTU: "A", Quantity: "1", Price: "5.00", Value: "5.00",
+		DiscountMarkup: &DiscountMarkup{Type: ValueDiscount, Value: "6.00"}}}
+	receipt := &Receipt{Items: []interface{}{item}, Summary: Summary{Total: "5.00"}}
+	if err := receipt.Validate(); err == nil || !strings.Contains(err.Error(), "item 0: discount_markup") {
+		t.Errorf("receipt: got %v", err)
+	}
+	invoice := &Invoice{Info: Info{Number: "1"}, Buyer: Buyer{Name: "b"}, Items: []interface{}{item}, Summary: Summary{Total: "5.00"}}
+	if err := invoice.Validate(); err == nil || !strings.Contains(err.Error(), "item 0: discount_markup") {
+		t.Errorf("invoice: got %v", err)
+	}
+	invalid := &Receipt{Items: []interface{}{article("A", "1.00"), map[string]interface{}{"article": Article{Name: "a", PTU: "A", Quantity: "2", Price: "1.00", Value: "1.00"}}}, Summary: Summary{Total: "2.00"}}
+	if err := invalid.Validate(); err == nil || !strings.Contains(err.Error(), "item 1:") {
+		t.Errorf("invalid article: got %v", err)
+	}
+}
+
+func TestArticleValueRounded(t *testing.T) {
+	tests := []struct {
+		quantity, price, value string
+		valid                  bool
+	}{
+		{"0.456", "12.99", "5.92", true},
+		{"0.455", "10.00", "4.55", true},
+		{"0.125", "1.00", "0.13", true},
+		{"0.456", "12.99", "5.93", false},
+		{"2", "1.00", "1.00", false},
+	}
+	for _, tt := range tests {
+		a := &Article{Name: "a", PTU: "A", Unit: "kg", Quantity: tt.quantity, Price: tt.price, Value: tt.value}
+		if err := a.Validate(); (err == nil) != tt.valid {
+			t.Errorf("%s x %s = %s: Validate() = %v, want valid %v", tt.quantity, tt.price, tt.value, err, tt.valid)
+		}
+	}
+}
+
+func TestDecodeItemTypedMap(t *testing.T) {
+	receipt := &Receipt{
+		Items:   []interface{}{map[string]Article{"article": {Name: "a", PTU: "A", Quantity: "1", Price: "1.00", Value: "1.00"}}},
+		Summary: Summary{Total: "1.00"},
+	}
+	if err := receipt.Validate(); err != nil {
+		t.Errorf("map[string]Article: %v", err)
+	}
+	if _, err := decodeItem(map[string]Advance{"article": {}}); err == nil {
+		t.Error("expected a key that does not match the item type to fail")
+	}
+	if _, err := decodeItem(map[string]Article{}); err == nil {
+		t.Error("expected an empty map to fail")
+	}
+	if _, err := decodeItem(42); err == nil || !strings.Contains(err.Error(), "unsupported item type int") {
+		t.Errorf("int: got %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Seller holds the seller data shown on electronic copies of documents.
+// The printer prints it from its own header, so it is not part of Receipt.
+type Seller struct {
+	Name    string
+	Nip     string
+	Address []string
+}
+
+// documentView is a Receipt or Invoice with all amounts calculated and
+// formatted, ready to be laid out by the renderers.
+type documentView struct {
+	Title       string
+	Number      string
+	DateOfSell  string
+	PaymentForm string
+	Seller      *Seller
+	Buyer       *Buyer
+	Recipient   string
+	Items       []itemView
+	Subtotal    string
+	Discount    *amountView
+	Taxes       []taxView
+	TaxTotal    taxView
+	Total       string
+	Payments    []amountView
+	PayIn       string
+	Change      string
+	Notes       []string
+	CashNumber  string
+	Cashier     string
+	RequestId   string
+}
+
+type itemView struct {
+	Name           string
+	Quantity       string
+	Price          string
+	Value          string
+	DiscountLabel  string
+	DiscountAmount string
+	Total          string
+	PTU            string
+}
+
+type amountView struct {
+	Label  string
+	Amount string
+}
+
+type taxView struct {
+	PTU   string
+	Rate  string
+	Gross string
+	Net   string
+	VAT   string
+}
+
+func newReceiptView(r *Receipt, seller *Seller, rates PTURates, requestId string) (*documentView, error) {
+	view := &documentView{Title: "Paragon fiskalny", Seller: seller, Buyer: r.Buyer, RequestId: requestId}
+	if err := view.fillSale(r.Items, r.Summary, r.Payments, rates); err != nil {
+		return nil, err
+	}
+	view.Notes = printoutLinesText(r.PrintoutLines)
+	if r.SystemInfo != nil {
+		view.CashNumber, view.Cashier = r.SystemInfo.CashNumer, r.SystemInfo.CashierName
+	}
+	return view, nil
+}
+
+func newInvoiceView(i *Invoice, seller *Seller, rates PTURates, requestId string) (*documentView, error) {
+	view := &documentView{
+		Title:       "Faktura VAT",
+		Number:      i.Info.Number,
+		DateOfSell:  i.Info.DateOfSell,
+		PaymentForm: i.Info.PaymentForm,
+		Seller:      seller,
+		RequestId:   requestId,
+	}
+	if view.Seller == nil && i.Seller != nil && i.Seller.Name != "" {
+		view.Seller = &Seller{Name: i.Seller.Name}
+	}
+	buyer := i.Buyer
+	view.Buyer = &buyer
+	if i.Recipient != nil {
+		view.Recipient = i.Recipient.Name
+	}
+	if err := view.fillSale(i.Items, i.Summary, i.Payments, rates); err != nil {
+		return nil, err
+	}
+	for _, info := range i.AdditionalInfo {
+		view.Notes = append(view.Notes, info.Text)
+	}
+	view.Notes = append(view.Notes, printoutLinesText(i.PrintoutLines)...)
+	view.CashNumber, view.Cashier = i.SystemInfo.CashNumer, i.SystemInfo.CashierName
+	return view, nil
+}
+
+func (v *documentView) fillSale(items []interface{}, summary Summary, payments []interface{}, rates PTURates) error {
+	if rates == nil {
+		rates = DefaultPTURates
+	}
+	itemViews, subtotal, err := saleItemViews(items)
+	if err != nil {
+		return err
+	}
+	v.Items = itemViews
+	v.Subtotal = formatAmount(subtotal)
+	if summary.DiscountMarkup != nil {
+		amount, err := summary.DiscountMarkup.Amount(subtotal)
+		if err != nil {
+			return fmt.Errorf("summary: %w", err)
+		}
+		v.Discount = &amountView{Label: discountMarkupLabel(summary.DiscountMarkup), Amount: formatAmount(amount)}
+	}
+	breakdown, err := computeTaxBreakdown(items, summary, rates)
+	if err != nil {
+		return err
+	}
+	for _, group := range breakdown.Groups {
+		v.Taxes = append(v.Taxes, taxView{
+			PTU:   group.PTU,
+			Rate:  formatAmount(group.Rate) + "%",
+			Gross: formatAmount(group.Gross),
+			Net:   formatAmount(group.Net),
+			VAT:   formatAmount(group.VAT),
+		})
+	}
+	v.TaxTotal = taxView{Gross: formatAmount(breakdown.Gross), Net: formatAmount(breakdown.Net), VAT: formatAmount(breakdown.VAT)}
+	v.Total = formatValueString(summary.Total)
+	v.Payments, err = paymentViews(payments)
+	if err != nil {
+		return err
+	}
+	if summary.PayIn != "" {
+		v.PayIn = formatValueString(summary.PayIn)
+	}
+	if summary.Change != "" {
+		v.Change = formatValueString(summary.Change)
+	}
+	return nil
+}
+
+// saleItemViews formats the items and returns them with the subtotal of
+// all taxed items after item discounts and markups.
+func saleItemViews(items []interface{}) ([]itemView, decimal.Decimal, error) {
+	subtotal := decimal.Zero
+	views := make([]itemView, 0, len(items))
+	for idx, item := range items {
+		decoded, err := decodeItem(item)
+		if err != nil {
+			return nil, decimal.Zero, fmt.Errorf("item %d: %w", idx, err)
+		}
+		switch it := decoded.(type) {
+		case *Article:
+			value, err := decimal.NewFromString(it.Value)
+			if err != nil {
+				return nil, decimal.Zero, fmt.Errorf("item %d: invalid value format: %w", idx, err)
+			}
+			price, err := decimal.NewFromString(it.Price)
+			if err != nil {
+				return nil, decimal.Zero, fmt.Errorf("item %d: invalid price format: %w", idx, err)
+			}
+			view := itemView{Name: it.Name, Quantity: formatQuantity(it.Quantity), Price: formatAmount(price), Value: formatAmount(value), PTU: it.PTU}
+			if it.Unit != "" {
+				view.Quantity += " " + it.Unit
+			}
+			if it.DiscountMarkup != nil {
+				amount, err := it.DiscountMarkup.Amount(value)
+				if err != nil {
+					return nil, decimal.Zero, fmt.Errorf("item %d: %w", idx, err)
+				}
+				view.DiscountLabel = discountMarkupLabel(it.DiscountMarkup)
+				view.DiscountAmount = formatAmount(amount)
+				value = value.Add(amount)
+			}
+			view.Total = formatAmount(value)
+			subtotal = subtotal.Add(value)
+			views = append(views, view)
+		case *Advance:
+			value, err := decimal.NewFromString(it.Value)
+			if err != nil {
+				return nil, decimal.Zero, fmt.Errorf("item %d: invalid value format: %w", idx, err)
+			}
+			views = append(views, itemView{Name: strings.TrimSpace("Zaliczka " + it.Description), Value: formatAmount(value), Total: formatAmount(value), PTU: it.PTU})
+			subtotal = subtotal.Add(value)
+		case *AdvanceReturn:
+			value, err := decimal.NewFromString(it.Value)
+			if err != nil {
+				return nil, decimal.Zero, fmt.Errorf("item %d: invalid value format: %w", idx, err)
+			}
+			views = append(views, itemView{Name: strings.TrimSpace("Zwrot zaliczki " + it.Description), Value: formatAmount(value.Neg()), Total: formatAmount(value.Neg()), PTU: it.PTU})
+			subtotal = subtotal.Sub(value)
+		case *Container:
+			views = append(views, itemView{Name: strings.TrimSpace("Opakowanie zwrotne " + it.Name), Quantity: formatQuantity(it.Quantity), Value: formatValueString(it.Value), Total: formatValueString(it.Value)})
+		case *ContainerReturn:
+			views = append(views, itemView{Name: strings.TrimSpace("Zwrot opakowania " + it.Name), Quantity: formatQuantity(it.Quantity), Value: "-" + formatValueString(it.Value), Total: "-" + formatValueString(it.Value)})
+		}
+	}
+	return views, subtotal, nil
+}
+
+func paymentViews(payments []interface{}) ([]amountView, error) {
+	views := make([]amountView, 0, len(payments))
+	for idx, payment := range payments {
+		decoded, err := decodePayment(payment)
+		if err != nil {
+			return nil, fmt.Errorf("payment %d: %w", idx, err)
+		}
+		switch p := decoded.(type) {
+		case *Cash:
+			views = append(views, amountView{Label: "Gotówka", Amount: formatValueString(p.Value)})
+		case *TypicalPaymentMethod:
+			label, ok := paymentLabels[p.Name]
+			if !ok {
+				label = p.Name
+			}
+			views = append(views, amountView{Label: label, Amount: formatValueString(p.Value)})
+		case *Currency:
+			label := "Waluta " + p.Name
+			if p.IsChange {
+				label = "Reszta " + p.Name
+			}
+			views = append(views, amountView{Label: label + " " + formatValueString(p.CurrencyValue), Amount: formatValueString(p.LocalValue)})
+		}
+	}
+	return views, nil
+}
+
+// printoutLinesText returns the text content of printout lines, skipping
+// lines that have no textual representation.
+func printoutLinesText(lines PrintoutLines) []string {
+	var texts []string
+	for _, line := range lines {
+		switch l := line.(type) {
+		case *TextLine:
+			if l.Masked {
+				texts = append(texts, maskText(l.Text))
+			} else {
+				texts = append(texts, l.Text)
+			}
+		case *PrintoutLine:
+			if l.Masked {
+				texts = append(texts, maskText(l.Text))
+			} else {
+				texts = append(texts, l.Text)
+			}
+		case *Barcode:
+			texts = append(texts, l.Code)
+		case *QRCode:
+			texts = append(texts, l.Code)
+		}
+	}
+	return texts
+}
@@ -0,0 +1,110 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type EDocumentProtocol string
+
+const (
+	EDocumentProtocolJSON EDocumentProtocol = "json"
+	EDocumentProtocolXML  EDocumentProtocol = "xml"
+)
+
+type PrintSendMode string
+
+const (
+	PrintOnly    PrintSendMode = "print"          // Print the document only
+	SendOnly     PrintSendMode = "send"           // Send the e-document without printing
+	PrintAndSend PrintSendMode = "print_and_send" // Print and send the e-document
+)
+
+// EDocumentFile is a decoded electronic document of a finished request.
+type EDocumentFile struct {
+	RequestId  string
+	ObjectType string // "receipt", "invoice" or "nf_printout"
+	Content    []byte
+}
+
+// DecodeEDocument decodes the e_document value returned in request status,
+// which the printer returns base64 encoded.
+func DecodeEDocument(encoded string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode e-document: %w", err)
+	}
+	return decoded, nil
+}
+
+// Protocol guesses the format of the document from its content.
+func (f EDocumentFile) Protocol() EDocumentProtocol {
+	content := bytes.TrimSpace(f.Content)
+	if bytes.HasPrefix(content, []byte("<")) {
+		return EDocumentProtocolXML
+	}
+	if bytes.HasPrefix(content, []byte("{")) || bytes.HasPrefix(content, []byte("[")) {
+		return EDocumentProtocolJSON
+	}
+	return ""
+}
+
+// FileName returns a file name for the document, e.g. "receipt_<id>.json".
+func (f EDocumentFile) FileName() string {
+	ext := ".bin"
+	switch f.Protocol() {
+	case EDocumentProtocolJSON:
+		ext = ".json"
+	case EDocumentProtocolXML:
+		ext = ".xml"
+	}
+	name := f.RequestId
+	if f.ObjectType != "" {
+		name = f.ObjectType + "_" + name
+	}
+	return strings.NewReplacer("/", "_", "\\", "_").Replace(name) + ext
+}
+
+func (f EDocumentFile) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(f.Content)
+	return int64(n), err
+}
+
+// Save writes the document to dir under FileName and returns its path.
+func (f EDocumentFile) Save(dir string) (string, error) {
+	path := filepath.Join(dir, f.FileName())
+	if err := os.WriteFile(path, f.Content, 0o644); err != nil {
+		return "", fmt.Errorf("failed to save e-document: %w", err)
+	}
+	return path, nil
+}
+
+// GetEDocument retrieves the electronic document of a finished request.
+// It fails if the request is not done yet or no e-document was produced,
+// e.g. because EDocument was not set on the document.
+func (n *NovitusClient) GetEDocument(objectType, requestId string) (EDocumentFile, error) {
+	status, err := n.CheckDocumentStatus(objectType, requestId)
+	if err != nil {
+		return EDocumentFile{}, fmt.Errorf("failed to get e-document: %w", err)
+	}
+	if status.Status != RequestStatusDone {
+		return EDocumentFile{}, fmt.Errorf("e-document is not available, request status is %s", status.Status)
+	}
+	if status.Request.EDocument == "" {
+		return EDocumentFile{}, fmt.Errorf("e-document is not available for request %s", requestId)
+	}
+	content, err := DecodeEDocument(status.Request.EDocument)
+	if err != nil {
+		return EDocumentFile{}, err
+	}
+	return EDocumentFile{
+		RequestId:  requestId,
+		ObjectType: objectType,
+		Content:    content,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeEDocument(t *testing.T) {
+	content := []byte(`{"receipt":{}}`)
+	decoded, err := DecodeEDocument(" " + base64.StdEncoding.EncodeToString(content) + "\n")
+	if err != nil || !bytes.Equal(decoded, content) {
+		t.Errorf("DecodeEDocument() = %q, %v", decoded, err)
+	}
+	for _, encoded := range []string{`{"receipt":{}}`, "abc", base64.RawURLEncoding.EncodeToString([]byte{0xfb, 0xff})} {
+		if _, err := DecodeEDocument(encoded); err == nil {
+			t.Errorf("DecodeEDocument(%q): expected an error", encoded)
+		}
+	}
+}
+
+func TestEDocumentFile(t *testing.T) {
+	tests := []struct {
+		file     EDocumentFile
+		protocol EDocumentProtocol
+		name     string
+	}{
+		{EDocumentFile{RequestId: "r1", ObjectType: "receipt", Content: []byte(" {}")}, EDocumentProtocolJSON, "receipt_r1.json"},
+		{EDocumentFile{RequestId: "r/2", ObjectType: "invoice", Content: []byte("<xml/>")}, EDocumentProtocolXML, "invoice_r_2.xml"},
+		{EDocumentFile{RequestId: "r3", Content: []byte("%PDF")}, "", "r3.bin"},
+	}
+	for _, tt := range tests {
+		if got := tt.file.Protocol(); got != tt.protocol {
+			t.Errorf("%s: Protocol() = %q, want %q", tt.name, got, tt.protocol)
+		}
+		if got := tt.file.FileName(); got != tt.name {
+			t.Errorf("FileName() = %q, want %q", got, tt.name)
+		}
+	}
+
+	dir := t.TempDir()
+	path, err := tests[0].file.Save(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved, _ := os.ReadFile(path); path != filepath.Join(dir, "receipt_r1.json") || string(saved) != " {}" {
+		t.Errorf("saved %q to %s", saved, path)
+	}
+}
+
+func TestGetEDocument(t *testing.T) {
+	client, f := newFakeClient(t)
+	response, err := client.SendReceipt(sampleReceipt(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := response.Request.Id
+	if _, err := client.GetEDocument("receipt", id); err == nil {
+		t.Error("expected an error for a stored request")
+	}
+
+	f.mu.Lock()
+	f.statuses[id] = RequestStatusDone
+	f.mu.Unlock()
+	if _, err := client.GetEDocument("receipt", id); err == nil {
+		t.Error("expected an error for a request without an e-document")
+	}
+
+	f.mu.Lock()
+	f.edocuments[id] = "not base64!"
+	f.mu.Unlock()
+	if _, err := client.GetEDocument("receipt", id); err == nil {
+		t.Error("expected an error for an e-document that is not base64")
+	}
+
+	f.mu.Lock()
+	f.edocuments[id] = base64.StdEncoding.EncodeToString([]byte("<paragon/>"))
+	f.mu.Unlock()
+	file, err := client.GetEDocument("receipt", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(file.Content) != "<paragon/>" || file.FileName() != "receipt_"+id+".xml" {
+		t.Errorf("got %q named %s", file.Content, file.FileName())
+	}
+}
@@ -0,0 +1,256 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventSent          EventType = "sent"           // Document stored on the printer
+	EventConfirmed     EventType = "confirmed"      // Document confirmed
+	EventStatusChanged EventType = "status_changed" // A status check returned a new status
+	EventCompleted     EventType = "completed"      // Document printed (DONE)
+	EventFailed        EventType = "failed"         // Printer reported ERROR, or sending or confirming failed
+	EventDeleted       EventType = "deleted"        // Document deleted with DeleteDocument
+)
+
+// Event is a lifecycle event of a document sent through the client.
+type Event struct {
+	Type         EventType
+	DocumentType string
+	RequestId    string // Empty if sending failed
+	Status       string // Request status, e.g. "CONFIRMED" or "DONE"
+	Device       Device // Device status, set for status events
+	Err          error
+	Time         time.Time
+}
+
+type DropPolicy string
+
+const (
+	DropNewest DropPolicy = "drop_newest" // A full subscription skips new events
+	DropOldest DropPolicy = "drop_oldest" // A full subscription discards its oldest event for the new one
+)
+
+type SubscribeOptions struct {
+	Buffer int         // Events buffered for the subscriber. Defaults to 64
+	Drop   DropPolicy  // What happens when the buffer is full. Defaults to DropNewest
+	Types  []EventType // Events to deliver, all if empty
+}
+
+// Subscription delivers events on a buffered channel. Events are never
+// waited for: if the subscriber does not keep up, events are dropped
+// according to the drop policy and counted by Dropped.
+type Subscription struct {
+	bus     *eventBus
+	options SubscribeOptions
+	events  chan Event
+	dropped int
+}
+
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns the number of events dropped so far.
+func (s *Subscription) Dropped() int {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	return s.dropped
+}
+
+// Unsubscribe stops the delivery and closes the events channel.
+func (s *Subscription) Unsubscribe() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	if _, ok := s.bus.subscriptions[s]; !ok {
+		return
+	}
+	s.bus.remove(s)
+}
+
+// Subscribe delivers the lifecycle events of documents sent through the
+// client, including those sent by SendAsync. A Pool has no subscription of
+// its own; its documents are published by the member client that handled
+// them, see Pool.Client. After Close the subscription is returned with its
+// events channel already closed.
+func (n *NovitusClient) Subscribe(options SubscribeOptions) *Subscription {
+	if options.Buffer <= 0 {
+		options.Buffer = 64
+	}
+	if options.Drop == "" {
+		options.Drop = DropNewest
+	}
+	s := &Subscription{bus: n.events, options: options, events: make(chan Event, options.Buffer)}
+	n.events.mu.Lock()
+	defer n.events.mu.Unlock()
+	if n.events.closed {
+		close(s.events)
+		return s
+	}
+	n.events.subscriptions[s] = struct{}{}
+	return s
+}
+
+const maxTrackedStatuses = 1024
+
+type trackedStatus struct {
+	status string
+	seq    uint64 // Order in which the request was first tracked
+}
+
+type eventBus struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	statuses      map[string]trackedStatus // Last known status by document type and request id, kept while subscribed
+	seq           uint64
+	closed        bool
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subscriptions: make(map[*Subscription]struct{}), statuses: make(map[string]trackedStatus)}
+}
+
+// track records the last known status of the request.
+func (b *eventBus) track(key, status string) {
+	tracked, ok := b.statuses[key]
+	if !ok {
+		b.seq++
+		tracked.seq = b.seq
+	}
+	tracked.status = status
+	b.statuses[key] = tracked
+	if !ok {
+		b.prune()
+	}
+}
+
+func (b *eventBus) remove(s *Subscription) {
+	delete(b.subscriptions, s)
+	close(s.events)
+	if len(b.subscriptions) == 0 {
+		clear(b.statuses)
+	}
+}
+
+func (b *eventBus) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for s := range b.subscriptions {
+		b.remove(s)
+	}
+}
+
+// publish delivers the event of a call. A failed send or confirm is
+// published as EventFailed.
+func (b *eventBus) publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subscriptions) == 0 {
+		return
+	}
+	key := event.DocumentType + "/" + event.RequestId
+	switch {
+	case event.Err != nil:
+		event.Type = EventFailed
+	case event.Type == EventSent, event.Type == EventConfirmed:
+		b.track(key, event.Status)
+	case event.Type == EventDeleted:
+		delete(b.statuses, key)
+	}
+	b.deliver(event)
+}
+
+// statusChecked publishes EventStatusChanged if the status differs from
+// the last known one, followed by EventCompleted or EventFailed once the
+// request is finished.
+func (b *eventBus) statusChecked(documentType string, status CheckDocumentStatusResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subscriptions) == 0 {
+		return
+	}
+	key := documentType + "/" + status.Request.Id
+	if last, ok := b.statuses[key]; ok && last.status == status.Request.Status {
+		return
+	}
+	event := Event{
+		Type:         EventStatusChanged,
+		DocumentType: documentType,
+		RequestId:    status.Request.Id,
+		Status:       status.Request.Status,
+		Device:       status.DeviceObj,
+		Time:         time.Now(),
+	}
+	b.track(key, status.Request.Status)
+	b.deliver(event)
+	switch status.Request.Status {
+	case RequestStatusDone:
+		event.Type = EventCompleted
+	case RequestStatusError:
+		event.Type = EventFailed
+		event.Err = fmt.Errorf("printer reported error %d: %s", status.Request.Error.Code, status.Request.Error.Description)
+	default:
+		return
+	}
+	b.deliver(event)
+}
+
+// prune forgets finished requests once too many statuses are tracked, and
+// then the oldest unfinished ones, e.g. documents never checked again,
+// down to three quarters of the limit. Checking a forgotten request again
+// publishes its events again.
+func (b *eventBus) prune() {
+	if len(b.statuses) <= maxTrackedStatuses {
+		return
+	}
+	for key, tracked := range b.statuses {
+		if tracked.status == RequestStatusDone || tracked.status == RequestStatusError {
+			delete(b.statuses, key)
+		}
+	}
+	if len(b.statuses) <= maxTrackedStatuses {
+		return
+	}
+	keys := make([]string, 0, len(b.statuses))
+	for key := range b.statuses {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return b.statuses[keys[i]].seq < b.statuses[keys[j]].seq })
+	for _, key := range keys[:len(keys)-maxTrackedStatuses*3/4] {
+		delete(b.statuses, key)
+	}
+}
+
+func (b *eventBus) deliver(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	for s := range b.subscriptions {
+		if len(s.options.Types) > 0 && !slices.Contains(s.options.Types, event.Type) {
+			continue
+		}
+		select {
+		case s.events <- event:
+			continue
+		default:
+		}
+		s.dropped++
+		if s.options.Drop == DropOldest {
+			select {
+			case <-s.events:
+			default:
+			}
+			select {
+			case s.events <- event:
+			default:
+			}
+		}
+	}
+}
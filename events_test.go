@@ -0,0 +1,131 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// drain returns the events buffered in the subscription.
+func drain(s *Subscription) []Event {
+	var events []Event
+	for {
+		select {
+		case event, ok := <-s.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func eventTypes(events []Event) string {
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = string(event.Type)
+	}
+	return fmt.Sprint(types)
+}
+
+func TestSubscribe(t *testing.T) {
+	client, f := newFakeClient(t)
+	sub := client.Subscribe(SubscribeOptions{})
+	defer sub.Unsubscribe()
+	if _, err := client.SendReceipt(sampleReceipt(), true); err != nil {
+		t.Fatal(err)
+	}
+	// The second check returns the same status and publishes nothing.
+	client.CheckDocumentStatus("receipt", "req1")
+	client.CheckDocumentStatus("receipt", "req1")
+	client.DeleteDocument("receipt", "req1")
+	f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method != http.MethodPost {
+			return false
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"bad"}}`)
+		return true
+	})
+	client.SendReceipt(sampleReceipt(), false)
+
+	events := drain(sub)
+	want := "[sent confirmed status_changed completed deleted failed]"
+	if got := eventTypes(events); got != want {
+		t.Fatalf("events = %s, want %s", got, want)
+	}
+	if events[2].Status != RequestStatusDone || events[2].RequestId != "req1" || events[2].Device.Status != "OK" {
+		t.Errorf("status event = %+v", events[2])
+	}
+	if events[5].Err == nil {
+		t.Error("failed send event without error")
+	}
+}
+
+func TestSubscribeOptions(t *testing.T) {
+	client, _ := newFakeClient(t)
+	newest := client.Subscribe(SubscribeOptions{Buffer: 1})
+	oldest := client.Subscribe(SubscribeOptions{Buffer: 1, Drop: DropOldest})
+	completed := client.Subscribe(SubscribeOptions{Types: []EventType{EventCompleted}})
+	if _, err := client.SendReceipt(sampleReceipt(), true); err != nil {
+		t.Fatal(err)
+	}
+	client.CheckDocumentStatus("receipt", "req1")
+
+	if events := drain(newest); len(events) != 1 || events[0].Type != EventSent || newest.Dropped() != 3 {
+		t.Errorf("DropNewest kept %s, dropped %d", eventTypes(events), newest.Dropped())
+	}
+	if events := drain(oldest); len(events) != 1 || events[0].Type != EventCompleted || oldest.Dropped() != 3 {
+		t.Errorf("DropOldest kept %s, dropped %d", eventTypes(events), oldest.Dropped())
+	}
+	if events := drain(completed); eventTypes(events) != "[completed]" {
+		t.Errorf("filtered events = %s", eventTypes(events))
+	}
+}
+
+func TestSubscribeClosed(t *testing.T) {
+	client, _ := newFakeClient(t)
+	before := client.Subscribe(SubscribeOptions{})
+	client.Close()
+	if _, ok := <-before.Events(); ok {
+		t.Error("Close left a subscription open")
+	}
+	after := client.Subscribe(SubscribeOptions{})
+	select {
+	case _, ok := <-after.Events():
+		if ok {
+			t.Error("event on a subscription after Close")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Subscribe after Close returned an open subscription")
+	}
+	after.Unsubscribe()
+	before.Unsubscribe()
+}
+
+func TestEventStatusesBounded(t *testing.T) {
+	b := newEventBus()
+	sub := &Subscription{bus: b, options: SubscribeOptions{Buffer: 1}, events: make(chan Event, 1)}
+	b.subscriptions[sub] = struct{}{}
+	// Documents stored but never checked again stay unfinished.
+	for i := range 3 * maxTrackedStatuses {
+		b.publish(Event{Type: EventSent, DocumentType: "receipt", RequestId: fmt.Sprint("req", i), Status: RequestStatusStored})
+	}
+	if len(b.statuses) > maxTrackedStatuses {
+		t.Errorf("tracking %d statuses, want at most %d", len(b.statuses), maxTrackedStatuses)
+	}
+	last := fmt.Sprint("receipt/req", 3*maxTrackedStatuses-1)
+	if _, ok := b.statuses[last]; !ok {
+		t.Error("newest request was forgotten")
+	}
+	if _, ok := b.statuses["receipt/req0"]; ok {
+		t.Error("oldest request still tracked")
+	}
+	sub.Unsubscribe()
+	if len(b.statuses) != 0 {
+		t.Errorf("%d statuses kept without subscribers", len(b.statuses))
+	}
+}
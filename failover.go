@@ -0,0 +1,239 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrNoHealthyPrinter = errors.New("no healthy printer")
+
+// FailoverPolicy controls when the pool stops using a printer and where
+// documents go instead. Non-fiscal printouts may go to any healthy
+// printer in the pool; fiscal documents only to the printers allowed for
+// the register with AllowFiscal.
+type FailoverPolicy struct {
+	MaxErrors     int           // Consecutive failed sends after which a printer is unhealthy. Defaults to 3
+	MaxQueue      int           // Printers with MaxQueue or more requests in queue are skipped. 0 disables the check
+	ProbeInterval time.Duration // Interval of RunProbes. Defaults to 30 seconds
+}
+
+type memberState struct {
+	errors    int
+	unhealthy bool
+	since     time.Time // When the printer was marked unhealthy
+	lastErr   error
+}
+
+// MemberStatus is the failover state of a printer in the pool.
+type MemberStatus struct {
+	Healthy   bool
+	Errors    int       // Consecutive failed sends
+	Since     time.Time // When the printer was marked unhealthy
+	LastError error
+}
+
+// SetFailover enables failover with the given policy.
+func (p *Pool) SetFailover(policy FailoverPolicy) {
+	if policy.MaxErrors <= 0 {
+		policy.MaxErrors = 3
+	}
+	if policy.ProbeInterval <= 0 {
+		policy.ProbeInterval = 30 * time.Second
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failover = &policy
+}
+
+func (p *Pool) failoverEnabled() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.failover != nil
+}
+
+// AllowFiscal allows the clients names, in order of preference, to print
+// fiscal documents of key when its routed printer is unhealthy, e.g. the
+// printers of the backup registers of the same store.
+func (p *Pool) AllowFiscal(key string, names ...string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, name := range names {
+		if _, ok := p.clients[name]; !ok {
+			return fmt.Errorf("unknown client %s", name)
+		}
+	}
+	p.allowed[key] = append([]string(nil), names...)
+	return nil
+}
+
+func (p *Pool) Status(name string) (MemberStatus, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	member, ok := p.members[name]
+	if !ok {
+		return MemberStatus{}, false
+	}
+	return MemberStatus{Healthy: !member.unhealthy, Errors: member.errors, Since: member.since, LastError: member.lastErr}, true
+}
+
+// MarkUnhealthy takes the printer out of rotation until a probe succeeds
+// or MarkHealthy is called, e.g. when staff report a paper jam.
+func (p *Pool) MarkUnhealthy(name string, reason error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if member, ok := p.members[name]; ok {
+		member.lastErr = reason
+		if !member.unhealthy {
+			member.unhealthy = true
+			member.since = time.Now()
+		}
+	}
+}
+
+func (p *Pool) MarkHealthy(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if member, ok := p.members[name]; ok {
+		*member = memberState{}
+	}
+}
+
+// Probe checks the queue and device status of every unhealthy printer and
+// re-admits those that recovered. It returns the names of re-admitted
+// printers.
+func (p *Pool) Probe() []string {
+	var unhealthy []string
+	p.mu.RLock()
+	for name, member := range p.members {
+		if member.unhealthy {
+			unhealthy = append(unhealthy, name)
+		}
+	}
+	p.mu.RUnlock()
+
+	var recovered []string
+	for _, name := range unhealthy {
+		client, ok := p.Client(name)
+		if !ok {
+			continue
+		}
+		if err := p.probe(client); err != nil {
+			p.MarkUnhealthy(name, err)
+			continue
+		}
+		p.MarkHealthy(name)
+		recovered = append(recovered, name)
+	}
+	return recovered
+}
+
+func (p *Pool) probe(client *NovitusClient) error {
+	if err := p.checkQueue(client); err != nil {
+		return err
+	}
+	deviceInfo, err := client.GetDeviceInfo()
+	if err != nil {
+		return err
+	}
+	if problems := deviceInfo.Device.Problems(); len(problems) > 0 {
+		return fmt.Errorf("device is not ready: %v", problems)
+	}
+	return nil
+}
+
+// RunProbes calls Probe every ProbeInterval until ctx is done.
+func (p *Pool) RunProbes(ctx context.Context) {
+	p.mu.RLock()
+	interval := 30 * time.Second
+	if p.failover != nil {
+		interval = p.failover.ProbeInterval
+	}
+	p.mu.RUnlock()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Probe()
+		}
+	}
+}
+
+// candidates returns the printers to try for the document in order. Only
+// the routed printer is returned without a failover policy. A key that
+// routes to no printer fails with ErrNoRoute, failover or not.
+func (p *Pool) candidates(key, documentType string) ([]string, error) {
+	primary, _, err := p.Resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.failover == nil {
+		return []string{primary}, nil
+	}
+	names := []string{primary}
+	if documentType == "nf_printout" {
+		var others []string
+		for name := range p.clients {
+			if name != primary {
+				others = append(others, name)
+			}
+		}
+		sort.Strings(others)
+		names = append(names, others...)
+	} else {
+		names = append(names, p.allowed[key]...)
+	}
+	healthy := names[:0]
+	for _, name := range names {
+		if member, ok := p.members[name]; ok && !member.unhealthy {
+			healthy = append(healthy, name)
+		}
+	}
+	return healthy, nil
+}
+
+// checkQueue fails if the printer has as many requests in queue as the
+// failover policy allows, the same limit Backpressure uses.
+func (p *Pool) checkQueue(client *NovitusClient) error {
+	p.mu.RLock()
+	policy := p.failover
+	p.mu.RUnlock()
+	if policy == nil || policy.MaxQueue <= 0 {
+		return nil
+	}
+	queue, err := client.GetQueueStatus()
+	if err != nil {
+		return err
+	}
+	if queue.RequestsInQueue >= policy.MaxQueue {
+		return &QueueFullError{RequestsInQueue: queue.RequestsInQueue, MaxQueue: policy.MaxQueue}
+	}
+	return nil
+}
+
+// record updates the failover state of the printer after a send.
+func (p *Pool) record(name string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	member, ok := p.members[name]
+	if !ok || p.failover == nil {
+		return
+	}
+	if err == nil {
+		*member = memberState{}
+		return
+	}
+	member.errors++
+	member.lastErr = err
+	if member.errors >= p.failover.MaxErrors && !member.unhealthy {
+		member.unhealthy = true
+		member.since = time.Now()
+	}
+}
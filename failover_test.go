@@ -0,0 +1,124 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFailover(t *testing.T) {
+	serverError := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method != http.MethodPost {
+			return false
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"boom"}}`)
+		return true
+	}
+	tests := []struct {
+		name        string
+		setup       func(pool *Pool, fakes map[string]*fakeNovitus)
+		wantPrinter string
+		wantErr     bool
+		backupCalls int
+	}{
+		{"healthy primary", func(pool *Pool, fakes map[string]*fakeNovitus) {}, "p1", false, 0},
+		{"primary refuses connections", func(pool *Pool, fakes map[string]*fakeNovitus) {
+			pool.Remove("p1")
+			pool.AddClient("p1", closedHostClient(t))
+			pool.Route("register-1", "p1")
+			pool.AllowFiscal("register-1", "p2")
+		}, "p2", false, 1},
+		{"primary queue at the limit", func(pool *Pool, fakes map[string]*fakeNovitus) {
+			fakes["p1"].queue = 5
+		}, "p2", false, 1},
+		{"primary unhealthy", func(pool *Pool, fakes map[string]*fakeNovitus) {
+			pool.MarkUnhealthy("p1", errors.New("paper jam"))
+		}, "p2", false, 1},
+		{"primary answers 5xx", func(pool *Pool, fakes map[string]*fakeNovitus) {
+			fakes["p1"].setHandle(serverError)
+		}, "p1", true, 0},
+		{"backup not allowed", func(pool *Pool, fakes map[string]*fakeNovitus) {
+			pool.AllowFiscal("register-1")
+			pool.MarkUnhealthy("p1", errors.New("paper jam"))
+		}, "", true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, fakes := newTestPool(t, RetryPolicy{}, "p1", "p2")
+			pool.Route("register-1", "p1")
+			pool.AllowFiscal("register-1", "p2")
+			pool.SetFailover(FailoverPolicy{MaxQueue: 5})
+			tt.setup(pool, fakes)
+			result, err := pool.SendReceipt("register-1", sampleReceipt(), true)
+			if (err != nil) != tt.wantErr || result.Printer != tt.wantPrinter {
+				t.Errorf("Send() = %q, %v", result.Printer, err)
+			}
+			if got := fakes["p2"].count("POST /api/v1/receipt"); got != tt.backupCalls {
+				t.Errorf("backup received %d sends, want %d", got, tt.backupCalls)
+			}
+		})
+	}
+}
+
+func TestFailoverPrintouts(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1", "p2", "p3")
+	pool.SetFailover(FailoverPolicy{})
+	pool.MarkUnhealthy("p1", errors.New("offline"))
+	pool.MarkUnhealthy("p2", errors.New("offline"))
+	result, err := pool.SendNFPrintout("p1", &Printout{Lines: PrintoutLines{&TextLine{Text: "a"}}}, false)
+	if err != nil || result.Printer != "p3" {
+		t.Fatalf("Send() = %q, %v", result.Printer, err)
+	}
+	if _, err := pool.SendReceipt("p1", sampleReceipt(), false); !errors.Is(err, ErrNoHealthyPrinter) {
+		t.Errorf("fiscal document went to a printer not allowed for it: %v", err)
+	}
+	if fakes["p3"].count("POST /api/v1/receipt") != 0 {
+		t.Error("receipt was sent to p3")
+	}
+}
+
+func TestFailoverUnrouted(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1", "p2")
+	pool.SetFailover(FailoverPolicy{})
+	pool.AllowFiscal("register-9", "p2")
+	if _, err := pool.SendNFPrintout("register-9", &Printout{Lines: PrintoutLines{&TextLine{Text: "a"}}}, false); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("printout: err = %v, want ErrNoRoute", err)
+	}
+	if _, err := pool.SendReceipt("register-9", sampleReceipt(), false); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("receipt: err = %v, want ErrNoRoute", err)
+	}
+	for name, f := range fakes {
+		if got := f.count("POST /api/v1/nf_printout") + f.count("POST /api/v1/receipt"); got != 0 {
+			t.Errorf("%s received %d documents for an unrouted key", name, got)
+		}
+	}
+}
+
+func TestFailoverHealth(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1")
+	pool.SetFailover(FailoverPolicy{MaxErrors: 2})
+	fakes["p1"].mu.Lock()
+	fakes["p1"].fail = http.StatusInternalServerError
+	fakes["p1"].mu.Unlock()
+	for range 2 {
+		pool.SendReceipt("p1", sampleReceipt(), false)
+	}
+	status, _ := pool.Status("p1")
+	if status.Healthy || status.Errors != 2 || status.LastError == nil {
+		t.Fatalf("status = %+v", status)
+	}
+	if recovered := pool.Probe(); len(recovered) != 0 {
+		t.Errorf("Probe() re-admitted %v while the printer fails", recovered)
+	}
+	fakes["p1"].mu.Lock()
+	fakes["p1"].fail = 0
+	fakes["p1"].mu.Unlock()
+	if recovered := pool.Probe(); len(recovered) != 1 {
+		t.Errorf("Probe() = %v", recovered)
+	}
+	if status, _ := pool.Status("p1"); !status.Healthy || status.Errors != 0 {
+		t.Errorf("status after probe = %+v", status)
+	}
+}
@@ -2,9 +2,9 @@ module github.com/Hkozacz/novitus_gosdk
 
 go 1.24
 
-require resty.dev/v3 v3.0.0-beta.3
-
 require (
-	github.com/shopspring/decimal v1.4.0 // indirect
-	golang.org/x/net v0.33.0 // indirect
+	github.com/shopspring/decimal v1.4.0
+	resty.dev/v3 v3.0.0-beta.3
 )
+
+require golang.org/x/net v0.33.0 // indirect
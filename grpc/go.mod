@@ -0,0 +1,18 @@
+module github.com/Hkozacz/novitus_gosdk/grpc
+
+go 1.24.0
+
+require (
+	github.com/Hkozacz/novitus_gosdk v0.0.0-20261019052544-4845336d8fe6
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260120221211-b8f7ae30c516
+	google.golang.org/grpc v1.80.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	github.com/shopspring/decimal v1.4.0 // indirect
+	golang.org/x/net v0.49.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/text v0.33.0 // indirect
+	resty.dev/v3 v3.0.0-beta.3 // indirect
+)
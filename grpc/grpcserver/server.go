@@ -0,0 +1,244 @@
+// Package grpcserver implements the novitus.v1.FiscalPrinter gRPC service
+// on top of a NovitusClient.
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+	"github.com/Hkozacz/novitus_gosdk/grpc/novituspb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Server struct {
+	novituspb.UnimplementedFiscalPrinterServer
+	client *novitus.NovitusClient
+}
+
+func New(client *novitus.NovitusClient) *Server {
+	return &Server{client: client}
+}
+
+func (s *Server) SendReceipt(ctx context.Context, req *novituspb.SendReceiptRequest) (*novituspb.DocumentStatus, error) {
+	receipt := &novitus.Receipt{}
+	if err := fromProto(req.GetReceipt(), receipt); err != nil {
+		return nil, err
+	}
+	return s.send(ctx, "receipt", receipt, req.GetConfirm())
+}
+
+func (s *Server) SendInvoice(ctx context.Context, req *novituspb.SendInvoiceRequest) (*novituspb.DocumentStatus, error) {
+	invoice := &novitus.Invoice{}
+	if err := fromProto(req.GetInvoice(), invoice); err != nil {
+		return nil, err
+	}
+	return s.send(ctx, "invoice", invoice, req.GetConfirm())
+}
+
+func (s *Server) SendPrintout(ctx context.Context, req *novituspb.SendPrintoutRequest) (*novituspb.DocumentStatus, error) {
+	printout := &novitus.Printout{}
+	if err := fromProto(req.GetPrintout(), printout); err != nil {
+		return nil, err
+	}
+	return s.send(ctx, "nf_printout", printout, req.GetConfirm())
+}
+
+func (s *Server) send(ctx context.Context, documentType string, document novitus.Document, confirm bool) (*novituspb.DocumentStatus, error) {
+	sendDocumentResponse, err := s.client.SendDocumentContext(ctx, documentType, document)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if confirm {
+		if _, err := s.client.Confirm(documentType, sendDocumentResponse.Request.Id); err != nil {
+			return nil, confirmError(documentType, sendDocumentResponse.Request.Id, err)
+		}
+	}
+	return s.documentStatus(documentType, sendDocumentResponse.Request.Id)
+}
+
+func (s *Server) Confirm(ctx context.Context, ref *novituspb.DocumentRef) (*novituspb.DocumentStatus, error) {
+	if err := checkRef(ref.GetObjectType(), ref.GetRequestId()); err != nil {
+		return nil, err
+	}
+	if _, err := s.client.Confirm(ref.GetObjectType(), ref.GetRequestId()); err != nil {
+		return nil, statusError(err)
+	}
+	return s.documentStatus(ref.GetObjectType(), ref.GetRequestId())
+}
+
+func (s *Server) GetDocumentStatus(ctx context.Context, ref *novituspb.DocumentRef) (*novituspb.DocumentStatus, error) {
+	if err := checkRef(ref.GetObjectType(), ref.GetRequestId()); err != nil {
+		return nil, err
+	}
+	return s.documentStatus(ref.GetObjectType(), ref.GetRequestId())
+}
+
+func (s *Server) DeleteDocument(ctx context.Context, ref *novituspb.DocumentRef) (*novituspb.DocumentStatus, error) {
+	if err := checkRef(ref.GetObjectType(), ref.GetRequestId()); err != nil {
+		return nil, err
+	}
+	deleteResponse, err := s.client.DeleteDocument(ref.GetObjectType(), ref.GetRequestId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	result := &novituspb.DocumentStatus{}
+	if err := toProto(deleteResponse, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WatchDocument polls the document status and sends it every time it
+// changes, until the request is finished or the client goes away.
+func (s *Server) WatchDocument(req *novituspb.WatchDocumentRequest, stream novituspb.FiscalPrinter_WatchDocumentServer) error {
+	if err := checkRef(req.GetObjectType(), req.GetRequestId()); err != nil {
+		return err
+	}
+	interval := 500 * time.Millisecond
+	if req.GetIntervalMs() > 0 {
+		interval = time.Duration(req.GetIntervalMs()) * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var last *novituspb.DocumentStatus
+	for {
+		current, err := s.client.CheckDocumentStatus(req.GetObjectType(), req.GetRequestId())
+		if err != nil {
+			return statusError(err)
+		}
+		result := &novituspb.DocumentStatus{}
+		if err := toProto(current, result); err != nil {
+			return err
+		}
+		if last == nil || !proto.Equal(last, result) {
+			if err := stream.Send(result); err != nil {
+				return err
+			}
+			last = result
+		}
+		if current.IsFinished() {
+			return nil
+		}
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) GetQueueStatus(ctx context.Context, _ *novituspb.GetQueueStatusRequest) (*novituspb.QueueStatus, error) {
+	queue, err := s.client.GetQueueStatus()
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &novituspb.QueueStatus{RequestsInQueue: int32(queue.RequestsInQueue)}, nil
+}
+
+func (s *Server) GetDevice(ctx context.Context, _ *novituspb.GetDeviceRequest) (*novituspb.DeviceInfo, error) {
+	deviceInfo, err := s.client.GetDeviceInfo()
+	if err != nil {
+		return nil, statusError(err)
+	}
+	result := &novituspb.DeviceInfo{}
+	if err := toProto(deviceInfo.Device, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Server) documentStatus(objectType, requestId string) (*novituspb.DocumentStatus, error) {
+	current, err := s.client.CheckDocumentStatus(objectType, requestId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	result := &novituspb.DocumentStatus{}
+	if err := toProto(current, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func checkRef(objectType, requestId string) error {
+	if objectType == "" || requestId == "" {
+		return status.Error(codes.InvalidArgument, "object_type and request_id are required")
+	}
+	return nil
+}
+
+// fromProto converts a message to its SDK type. Message field names
+// mirror the API JSON, so the proto JSON form decodes directly.
+func fromProto(message proto.Message, target any) error {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to encode document: %v", err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to decode document: %v", err)
+	}
+	return nil
+}
+
+// toProto converts an SDK response to its message.
+func toProto(value any, message proto.Message) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode response: %v", err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, message); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode response: %v", err)
+	}
+	return nil
+}
+
+// confirmError is the status of a document stored on the printer whose
+// confirm failed. Its ErrorInfo detail carries the object type and request
+// id, so the caller can retry Confirm or delete the document.
+func confirmError(objectType, requestId string, err error) error {
+	st := status.Convert(statusError(err))
+	withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "CONFIRM_FAILED",
+		Domain:   "novitus.v1",
+		Metadata: map[string]string{"object_type": objectType, "request_id": requestId},
+	})
+	if detailsErr != nil {
+		return status.Errorf(st.Code(), "failed to confirm request %s: %s", requestId, st.Message())
+	}
+	return withDetails.Err()
+}
+
+// statusError maps client errors to gRPC status codes.
+func statusError(err error) error {
+	var queueFull *novitus.QueueFullError
+	var statusErr *novitus.StatusError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, novitus.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, novitus.ErrCircuitOpen):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.As(err, &queueFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &statusErr) && statusErr.StatusCode >= http.StatusInternalServerError:
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.As(err, &netErr):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Unknown, fmt.Sprint(err))
+}
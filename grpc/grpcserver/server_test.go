@@ -0,0 +1,235 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	novitus "github.com/Hkozacz/novitus_gosdk"
+	"github.com/Hkozacz/novitus_gosdk/grpc/novituspb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakePrinter stores documents like a Novitus printer. A confirmed
+// document is PENDING on the first status check and DONE on the next.
+type fakePrinter struct {
+	mu          sync.Mutex
+	statuses    map[string]string
+	bodies      []string
+	failConfirm bool
+}
+
+func (f *fakePrinter) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	switch {
+	case parts[0] == "token":
+		json.NewEncoder(w).Encode(map[string]string{"token": "t", "expiration_date": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	case parts[0] == "queue":
+		fmt.Fprint(w, `{"requests_in_queue":2}`)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		body, _ := io.ReadAll(r.Body)
+		f.bodies = append(f.bodies, string(body))
+		f.statuses["req1"] = novitus.RequestStatusStored
+		fmt.Fprint(w, `{"request":{"id":"req1","status":"STORED"}}`)
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		if f.failConfirm {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"exception":{"code":1,"description":"busy"}}`)
+			return
+		}
+		f.statuses[parts[1]] = novitus.RequestStatusConfirmed
+		fmt.Fprintf(w, `{"request":{"id":%q,"status":"CONFIRMED"}}`, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		status, ok := f.statuses[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":{"code":404,"description":"not found"}}`)
+			return
+		}
+		switch status {
+		case novitus.RequestStatusConfirmed:
+			f.statuses[parts[1]] = novitus.RequestStatusPending
+		case novitus.RequestStatusPending:
+			f.statuses[parts[1]] = novitus.RequestStatusDone
+		}
+		fmt.Fprintf(w, `{"device":{"status":"OK"},"request":{"id":%q,"status":%q}}`, parts[1], status)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"exception":{"code":404,"description":"unknown"}}`)
+	}
+}
+
+// newTestClient serves a Server for a fake printer over an in-memory
+// connection.
+func newTestClient(t *testing.T, options ...novitus.ClientOption) (novituspb.FiscalPrinterClient, *fakePrinter) {
+	f := &fakePrinter{statuses: map[string]string{}}
+	printer := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(printer.Close)
+	client, err := novitus.NewNovitusClient(printer.URL, "", options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	novituspb.RegisterFiscalPrinterServer(server, New(client))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return novituspb.NewFiscalPrinterClient(conn), f
+}
+
+func sampleReceipt() *novituspb.Receipt {
+	return &novituspb.Receipt{
+		Items: []*novituspb.Item{{Kind: &novituspb.Item_Article{Article: &novituspb.Article{Name: "a", Ptu: "A", Quantity: "1", Price: "1.00", Value: "1.00"}}}},
+		Payments: []*novituspb.Payment{{Kind: &novituspb.Payment_Currency{Currency: &novituspb.Currency{
+			Course: "4.00", CurrencyValue: "1.00", LocalValue: "4.00", Name: "USD", IsChange: true,
+		}}}},
+		Summary: &novituspb.Summary{Total: "1.00"},
+	}
+}
+
+func TestSendReceipt(t *testing.T) {
+	client, f := newTestClient(t)
+	result, err := client.SendReceipt(t.Context(), &novituspb.SendReceiptRequest{Receipt: sampleReceipt(), Confirm: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.GetRequest().GetId() != "req1" || result.GetRequest().GetStatus() != novitus.RequestStatusConfirmed {
+		t.Errorf("result = %v", result)
+	}
+	if len(f.bodies) != 1 || !strings.Contains(f.bodies[0], `"is_change":true`) {
+		t.Errorf("sent %v, want is_change in the currency payment", f.bodies)
+	}
+}
+
+func TestSendReceiptInvalid(t *testing.T) {
+	client, f := newTestClient(t)
+	receipt := sampleReceipt()
+	receipt.Summary = nil
+	_, err := client.SendReceipt(t.Context(), &novituspb.SendReceiptRequest{Receipt: receipt})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "Validation Error") {
+		t.Errorf("err = %v, want InvalidArgument", err)
+	}
+	if len(f.bodies) != 0 {
+		t.Errorf("invalid receipt was sent")
+	}
+}
+
+func TestWatchDocument(t *testing.T) {
+	client, _ := newTestClient(t)
+	if _, err := client.SendReceipt(t.Context(), &novituspb.SendReceiptRequest{Receipt: sampleReceipt(), Confirm: true}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.WatchDocument(t.Context(), &novituspb.WatchDocumentRequest{ObjectType: "receipt", RequestId: "req1", IntervalMs: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statuses []string
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		statuses = append(statuses, result.GetRequest().GetStatus())
+	}
+	if got := strings.Join(statuses, ","); got != "PENDING,DONE" {
+		t.Errorf("statuses = %s, want PENDING,DONE", got)
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	client, _ := newTestClient(t, novitus.WithBackpressure(novitus.Backpressure{MaxQueue: 1, Mode: novitus.BackpressureReject}))
+	_, err := client.SendReceipt(t.Context(), &novituspb.SendReceiptRequest{Receipt: sampleReceipt()})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("full queue: err = %v, want ResourceExhausted", err)
+	}
+	_, err = client.GetDocumentStatus(t.Context(), &novituspb.DocumentRef{ObjectType: "receipt"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("missing request id: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSendConfirmFailed(t *testing.T) {
+	client, f := newTestClient(t)
+	f.mu.Lock()
+	f.failConfirm = true
+	f.mu.Unlock()
+	_, err := client.SendReceipt(t.Context(), &novituspb.SendReceiptRequest{Receipt: sampleReceipt(), Confirm: true})
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Errorf("code = %s, want Unavailable", st.Code())
+	}
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = d
+		}
+	}
+	if info == nil || info.GetReason() != "CONFIRM_FAILED" || info.GetMetadata()["request_id"] != "req1" || info.GetMetadata()["object_type"] != "receipt" {
+		t.Fatalf("details = %v", st.Details())
+	}
+}
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"validation", fmt.Errorf("%w: total is required", novitus.ErrValidation), codes.InvalidArgument},
+		{"circuit open", novitus.ErrCircuitOpen, codes.Unavailable},
+		{"queue full", &novitus.QueueFullError{RequestsInQueue: 3, MaxQueue: 2}, codes.ResourceExhausted},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"not found", fmt.Errorf("error checking status: %w", &novitus.StatusError{StatusCode: http.StatusNotFound}), codes.NotFound},
+		{"server error", &novitus.StatusError{StatusCode: http.StatusBadGateway}, codes.Unavailable},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, codes.Unavailable},
+		{"timeout", fmt.Errorf("failed to send document: %w", &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}), codes.DeadlineExceeded},
+		{"printer rejected", &novitus.StatusError{StatusCode: http.StatusConflict}, codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := status.Code(statusError(tt.err)); got != tt.want {
+			t.Errorf("%s: code = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	client, _ := newTestClient(t)
+	_, err := client.GetDocumentStatus(t.Context(), &novituspb.DocumentRef{ObjectType: "receipt", RequestId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("err = %v, want NotFound", err)
+	}
+}
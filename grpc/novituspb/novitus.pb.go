@@ -0,0 +1,3696 @@
+// Fiscal printing over gRPC, backed by the Novitus API.
+//
+// Field names mirror the JSON of the Novitus API, so messages convert to
+// the SDK types one to one. Amounts are decimal strings, e.g. "19.99".
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: novitus/v1/novitus.proto
+
+package novituspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SendReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Confirm       bool                   `protobuf:"varint,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendReceiptRequest) Reset() {
+	*x = SendReceiptRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReceiptRequest) ProtoMessage() {}
+
+func (x *SendReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReceiptRequest.ProtoReflect.Descriptor instead.
+func (*SendReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendReceiptRequest) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *SendReceiptRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type SendInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	Confirm       bool                   `protobuf:"varint,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendInvoiceRequest) Reset() {
+	*x = SendInvoiceRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendInvoiceRequest) ProtoMessage() {}
+
+func (x *SendInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*SendInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendInvoiceRequest) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *SendInvoiceRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type SendPrintoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Printout      *Printout              `protobuf:"bytes,1,opt,name=printout,proto3" json:"printout,omitempty"`
+	Confirm       bool                   `protobuf:"varint,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPrintoutRequest) Reset() {
+	*x = SendPrintoutRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPrintoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPrintoutRequest) ProtoMessage() {}
+
+func (x *SendPrintoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPrintoutRequest.ProtoReflect.Descriptor instead.
+func (*SendPrintoutRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendPrintoutRequest) GetPrintout() *Printout {
+	if x != nil {
+		return x.Printout
+	}
+	return nil
+}
+
+func (x *SendPrintoutRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type DocumentRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectType    string                 `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"` // "receipt", "invoice" or "nf_printout"
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentRef) Reset() {
+	*x = DocumentRef{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentRef) ProtoMessage() {}
+
+func (x *DocumentRef) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentRef.ProtoReflect.Descriptor instead.
+func (*DocumentRef) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DocumentRef) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *DocumentRef) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type WatchDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectType    string                 `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IntervalMs    int32                  `protobuf:"varint,3,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"` // Polling interval, defaults to 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDocumentRequest) Reset() {
+	*x = WatchDocumentRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDocumentRequest) ProtoMessage() {}
+
+func (x *WatchDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDocumentRequest.ProtoReflect.Descriptor instead.
+func (*WatchDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchDocumentRequest) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *WatchDocumentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WatchDocumentRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type GetQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueStatusRequest) Reset() {
+	*x = GetQueueStatusRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{5}
+}
+
+type QueueStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestsInQueue int32                  `protobuf:"varint,1,opt,name=requests_in_queue,json=requestsInQueue,proto3" json:"requests_in_queue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueueStatus) Reset() {
+	*x = QueueStatus{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatus) ProtoMessage() {}
+
+func (x *QueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatus.ProtoReflect.Descriptor instead.
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueueStatus) GetRequestsInQueue() int32 {
+	if x != nil {
+		return x.RequestsInQueue
+	}
+	return 0
+}
+
+type GetDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceRequest) Reset() {
+	*x = GetDeviceRequest{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceRequest) ProtoMessage() {}
+
+func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{7}
+}
+
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Error) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "STORED", "CONFIRMED", "PENDING", "DONE" or "ERROR"
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	EDocument     string                 `protobuf:"bytes,3,opt,name=e_document,json=eDocument,proto3" json:"e_document,omitempty"`
+	Jpkid         string                 `protobuf:"bytes,4,opt,name=jpkid,proto3" json:"jpkid,omitempty"`
+	Error         *Error                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Request) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Request) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Request) GetEDocument() string {
+	if x != nil {
+		return x.EDocument
+	}
+	return ""
+}
+
+func (x *Request) GetJpkid() string {
+	if x != nil {
+		return x.Jpkid
+	}
+	return ""
+}
+
+func (x *Request) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Device) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Device) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DocumentStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Request       *Request               `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentStatus) Reset() {
+	*x = DocumentStatus{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentStatus) ProtoMessage() {}
+
+func (x *DocumentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentStatus.ProtoReflect.Descriptor instead.
+func (*DocumentStatus) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DocumentStatus) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DocumentStatus) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DeviceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         string                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Firmware      string                 `protobuf:"bytes,2,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	UniqueNumber  string                 `protobuf:"bytes,4,opt,name=unique_number,json=uniqueNumber,proto3" json:"unique_number,omitempty"`
+	FiscalState   string                 `protobuf:"bytes,5,opt,name=fiscal_state,json=fiscalState,proto3" json:"fiscal_state,omitempty"` // "non_fiscal", "fiscal" or "read_only"
+	Paper         string                 `protobuf:"bytes,6,opt,name=paper,proto3" json:"paper,omitempty"`                                // "ok", "near_end" or "out"
+	CoverOpen     bool                   `protobuf:"varint,7,opt,name=cover_open,json=coverOpen,proto3" json:"cover_open,omitempty"`
+	Clock         string                 `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Error         *Error                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceInfo) Reset() {
+	*x = DeviceInfo{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceInfo) ProtoMessage() {}
+
+func (x *DeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceInfo.ProtoReflect.Descriptor instead.
+func (*DeviceInfo) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeviceInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetFirmware() string {
+	if x != nil {
+		return x.Firmware
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetUniqueNumber() string {
+	if x != nil {
+		return x.UniqueNumber
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetFiscalState() string {
+	if x != nil {
+		return x.FiscalState
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetPaper() string {
+	if x != nil {
+		return x.Paper
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetCoverOpen() bool {
+	if x != nil {
+		return x.CoverOpen
+	}
+	return false
+}
+
+func (x *DeviceInfo) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DiscountMarkup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "percent_discount", "percent_markup", "value_discount" or "value_markup"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountMarkup) Reset() {
+	*x = DiscountMarkup{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountMarkup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountMarkup) ProtoMessage() {}
+
+func (x *DiscountMarkup) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountMarkup.ProtoReflect.Descriptor instead.
+func (*DiscountMarkup) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiscountMarkup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DiscountMarkup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiscountMarkup) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Summary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DiscountMarkup *DiscountMarkup        `protobuf:"bytes,1,opt,name=discount_markup,json=discountMarkup,proto3" json:"discount_markup,omitempty"`
+	Total          string                 `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	PayIn          string                 `protobuf:"bytes,3,opt,name=pay_in,json=payIn,proto3" json:"pay_in,omitempty"`
+	Change         string                 `protobuf:"bytes,4,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Summary) GetDiscountMarkup() *DiscountMarkup {
+	if x != nil {
+		return x.DiscountMarkup
+	}
+	return nil
+}
+
+func (x *Summary) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Summary) GetPayIn() string {
+	if x != nil {
+		return x.PayIn
+	}
+	return ""
+}
+
+func (x *Summary) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+type EDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`                                  // "json" or "xml"
+	PrintSendMode string                 `protobuf:"bytes,3,opt,name=print_send_mode,json=printSendMode,proto3" json:"print_send_mode,omitempty"` // "print", "send" or "print_and_send"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EDocument) Reset() {
+	*x = EDocument{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EDocument) ProtoMessage() {}
+
+func (x *EDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EDocument.ProtoReflect.Descriptor instead.
+func (*EDocument) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EDocument) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *EDocument) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *EDocument) GetPrintSendMode() string {
+	if x != nil {
+		return x.PrintSendMode
+	}
+	return ""
+}
+
+type Buyer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IdType        string                 `protobuf:"bytes,2,opt,name=id_type,json=idType,proto3" json:"id_type,omitempty"` // "nip", "regon", "pesel", "vat_ue" or "other"
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	LabelType     string                 `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3" json:"label_type,omitempty"`
+	Address       []string               `protobuf:"bytes,5,rep,name=address,proto3" json:"address,omitempty"`
+	Nip           string                 `protobuf:"bytes,6,opt,name=nip,proto3" json:"nip,omitempty"`
+	EDocument     *EDocument             `protobuf:"bytes,7,opt,name=e_document,json=eDocument,proto3" json:"e_document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Buyer) Reset() {
+	*x = Buyer{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Buyer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Buyer) ProtoMessage() {}
+
+func (x *Buyer) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Buyer.ProtoReflect.Descriptor instead.
+func (*Buyer) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Buyer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Buyer) GetIdType() string {
+	if x != nil {
+		return x.IdType
+	}
+	return ""
+}
+
+func (x *Buyer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Buyer) GetLabelType() string {
+	if x != nil {
+		return x.LabelType
+	}
+	return ""
+}
+
+func (x *Buyer) GetAddress() []string {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Buyer) GetNip() string {
+	if x != nil {
+		return x.Nip
+	}
+	return ""
+}
+
+func (x *Buyer) GetEDocument() *EDocument {
+	if x != nil {
+		return x.EDocument
+	}
+	return nil
+}
+
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CashierName   string                 `protobuf:"bytes,1,opt,name=cashier_name,json=cashierName,proto3" json:"cashier_name,omitempty"`
+	CashNumber    string                 `protobuf:"bytes,2,opt,name=cash_number,json=cashNumber,proto3" json:"cash_number,omitempty"`
+	SystemNumber  string                 `protobuf:"bytes,3,opt,name=system_number,json=systemNumber,proto3" json:"system_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SystemInfo) GetCashierName() string {
+	if x != nil {
+		return x.CashierName
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetCashNumber() string {
+	if x != nil {
+		return x.CashNumber
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetSystemNumber() string {
+	if x != nil {
+		return x.SystemNumber
+	}
+	return ""
+}
+
+type DeviceControl struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OpenDrawer        bool                   `protobuf:"varint,1,opt,name=open_drawer,json=openDrawer,proto3" json:"open_drawer,omitempty"`
+	FeedAfterPrintout bool                   `protobuf:"varint,2,opt,name=feed_after_printout,json=feedAfterPrintout,proto3" json:"feed_after_printout,omitempty"`
+	PaperCut          string                 `protobuf:"bytes,3,opt,name=paper_cut,json=paperCut,proto3" json:"paper_cut,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeviceControl) Reset() {
+	*x = DeviceControl{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceControl) ProtoMessage() {}
+
+func (x *DeviceControl) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceControl.ProtoReflect.Descriptor instead.
+func (*DeviceControl) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeviceControl) GetOpenDrawer() bool {
+	if x != nil {
+		return x.OpenDrawer
+	}
+	return false
+}
+
+func (x *DeviceControl) GetFeedAfterPrintout() bool {
+	if x != nil {
+		return x.FeedAfterPrintout
+	}
+	return false
+}
+
+func (x *DeviceControl) GetPaperCut() string {
+	if x != nil {
+		return x.PaperCut
+	}
+	return ""
+}
+
+type Article struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ptu            string                 `protobuf:"bytes,2,opt,name=ptu,proto3" json:"ptu,omitempty"`
+	Quantity       string                 `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Value          string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Unit           string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	DiscountMarkup *DiscountMarkup        `protobuf:"bytes,7,opt,name=discount_markup,json=discountMarkup,proto3" json:"discount_markup,omitempty"`
+	Code           string                 `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Article) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Article) GetPtu() string {
+	if x != nil {
+		return x.Ptu
+	}
+	return ""
+}
+
+func (x *Article) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Article) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Article) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Article) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Article) GetDiscountMarkup() *DiscountMarkup {
+	if x != nil {
+		return x.DiscountMarkup
+	}
+	return nil
+}
+
+func (x *Article) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Advance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Ptu           string                 `protobuf:"bytes,2,opt,name=ptu,proto3" json:"ptu,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advance) Reset() {
+	*x = Advance{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advance) ProtoMessage() {}
+
+func (x *Advance) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advance.ProtoReflect.Descriptor instead.
+func (*Advance) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Advance) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Advance) GetPtu() string {
+	if x != nil {
+		return x.Ptu
+	}
+	return ""
+}
+
+func (x *Advance) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Container struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Quantity      string                 `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Container) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Container) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Item struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Item_Article
+	//	*Item_Advance
+	//	*Item_AdvanceReturn
+	//	*Item_Container
+	//	*Item_ContainerReturn
+	Kind          isItem_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Item) GetKind() isItem_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Item) GetArticle() *Article {
+	if x != nil {
+		if x, ok := x.Kind.(*Item_Article); ok {
+			return x.Article
+		}
+	}
+	return nil
+}
+
+func (x *Item) GetAdvance() *Advance {
+	if x != nil {
+		if x, ok := x.Kind.(*Item_Advance); ok {
+			return x.Advance
+		}
+	}
+	return nil
+}
+
+func (x *Item) GetAdvanceReturn() *Advance {
+	if x != nil {
+		if x, ok := x.Kind.(*Item_AdvanceReturn); ok {
+			return x.AdvanceReturn
+		}
+	}
+	return nil
+}
+
+func (x *Item) GetContainer() *Container {
+	if x != nil {
+		if x, ok := x.Kind.(*Item_Container); ok {
+			return x.Container
+		}
+	}
+	return nil
+}
+
+func (x *Item) GetContainerReturn() *Container {
+	if x != nil {
+		if x, ok := x.Kind.(*Item_ContainerReturn); ok {
+			return x.ContainerReturn
+		}
+	}
+	return nil
+}
+
+type isItem_Kind interface {
+	isItem_Kind()
+}
+
+type Item_Article struct {
+	Article *Article `protobuf:"bytes,1,opt,name=article,proto3,oneof"`
+}
+
+type Item_Advance struct {
+	Advance *Advance `protobuf:"bytes,2,opt,name=advance,proto3,oneof"`
+}
+
+type Item_AdvanceReturn struct {
+	AdvanceReturn *Advance `protobuf:"bytes,3,opt,name=advance_return,json=advanceReturn,proto3,oneof"`
+}
+
+type Item_Container struct {
+	Container *Container `protobuf:"bytes,4,opt,name=container,proto3,oneof"`
+}
+
+type Item_ContainerReturn struct {
+	ContainerReturn *Container `protobuf:"bytes,5,opt,name=container_return,json=containerReturn,proto3,oneof"`
+}
+
+func (*Item_Article) isItem_Kind() {}
+
+func (*Item_Advance) isItem_Kind() {}
+
+func (*Item_AdvanceReturn) isItem_Kind() {}
+
+func (*Item_Container) isItem_Kind() {}
+
+func (*Item_ContainerReturn) isItem_Kind() {}
+
+type Cash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cash) Reset() {
+	*x = Cash{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cash) ProtoMessage() {}
+
+func (x *Cash) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cash.ProtoReflect.Descriptor instead.
+func (*Cash) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Cash) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Currency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        string                 `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	CurrencyValue string                 `protobuf:"bytes,2,opt,name=currency_value,json=currencyValue,proto3" json:"currency_value,omitempty"`
+	LocalValue    string                 `protobuf:"bytes,3,opt,name=local_value,json=localValue,proto3" json:"local_value,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	IsChange      bool                   `protobuf:"varint,5,opt,name=is_change,json=isChange,proto3" json:"is_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Currency) GetCourse() string {
+	if x != nil {
+		return x.Course
+	}
+	return ""
+}
+
+func (x *Currency) GetCurrencyValue() string {
+	if x != nil {
+		return x.CurrencyValue
+	}
+	return ""
+}
+
+func (x *Currency) GetLocalValue() string {
+	if x != nil {
+		return x.LocalValue
+	}
+	return ""
+}
+
+func (x *Currency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Currency) GetIsChange() bool {
+	if x != nil {
+		return x.IsChange
+	}
+	return false
+}
+
+type TypicalPaymentMethod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypicalPaymentMethod) Reset() {
+	*x = TypicalPaymentMethod{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypicalPaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypicalPaymentMethod) ProtoMessage() {}
+
+func (x *TypicalPaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypicalPaymentMethod.ProtoReflect.Descriptor instead.
+func (*TypicalPaymentMethod) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TypicalPaymentMethod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypicalPaymentMethod) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Payment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Payment_Cash
+	//	*Payment_Currency
+	//	*Payment_Card
+	//	*Payment_Cheque
+	//	*Payment_Coupon
+	//	*Payment_Other
+	//	*Payment_Credit
+	//	*Payment_Account
+	//	*Payment_Transfer
+	//	*Payment_Mobile
+	//	*Payment_Voucher
+	Kind          isPayment_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Payment) GetKind() isPayment_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Payment) GetCash() *Cash {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Cash); ok {
+			return x.Cash
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetCurrency() *Currency {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Currency); ok {
+			return x.Currency
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetCard() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Card); ok {
+			return x.Card
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetCheque() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Cheque); ok {
+			return x.Cheque
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetCoupon() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Coupon); ok {
+			return x.Coupon
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetOther() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Other); ok {
+			return x.Other
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetCredit() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Credit); ok {
+			return x.Credit
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetAccount() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Account); ok {
+			return x.Account
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetTransfer() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Transfer); ok {
+			return x.Transfer
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetMobile() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Mobile); ok {
+			return x.Mobile
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetVoucher() *TypicalPaymentMethod {
+	if x != nil {
+		if x, ok := x.Kind.(*Payment_Voucher); ok {
+			return x.Voucher
+		}
+	}
+	return nil
+}
+
+type isPayment_Kind interface {
+	isPayment_Kind()
+}
+
+type Payment_Cash struct {
+	Cash *Cash `protobuf:"bytes,1,opt,name=cash,proto3,oneof"`
+}
+
+type Payment_Currency struct {
+	Currency *Currency `protobuf:"bytes,2,opt,name=currency,proto3,oneof"`
+}
+
+type Payment_Card struct {
+	Card *TypicalPaymentMethod `protobuf:"bytes,3,opt,name=card,proto3,oneof"`
+}
+
+type Payment_Cheque struct {
+	Cheque *TypicalPaymentMethod `protobuf:"bytes,4,opt,name=cheque,proto3,oneof"`
+}
+
+type Payment_Coupon struct {
+	Coupon *TypicalPaymentMethod `protobuf:"bytes,5,opt,name=coupon,proto3,oneof"`
+}
+
+type Payment_Other struct {
+	Other *TypicalPaymentMethod `protobuf:"bytes,6,opt,name=other,proto3,oneof"`
+}
+
+type Payment_Credit struct {
+	Credit *TypicalPaymentMethod `protobuf:"bytes,7,opt,name=credit,proto3,oneof"`
+}
+
+type Payment_Account struct {
+	Account *TypicalPaymentMethod `protobuf:"bytes,8,opt,name=account,proto3,oneof"`
+}
+
+type Payment_Transfer struct {
+	Transfer *TypicalPaymentMethod `protobuf:"bytes,9,opt,name=transfer,proto3,oneof"`
+}
+
+type Payment_Mobile struct {
+	Mobile *TypicalPaymentMethod `protobuf:"bytes,10,opt,name=mobile,proto3,oneof"`
+}
+
+type Payment_Voucher struct {
+	Voucher *TypicalPaymentMethod `protobuf:"bytes,11,opt,name=voucher,proto3,oneof"`
+}
+
+func (*Payment_Cash) isPayment_Kind() {}
+
+func (*Payment_Currency) isPayment_Kind() {}
+
+func (*Payment_Card) isPayment_Kind() {}
+
+func (*Payment_Cheque) isPayment_Kind() {}
+
+func (*Payment_Coupon) isPayment_Kind() {}
+
+func (*Payment_Other) isPayment_Kind() {}
+
+func (*Payment_Credit) isPayment_Kind() {}
+
+func (*Payment_Account) isPayment_Kind() {}
+
+func (*Payment_Transfer) isPayment_Kind() {}
+
+func (*Payment_Mobile) isPayment_Kind() {}
+
+func (*Payment_Voucher) isPayment_Kind() {}
+
+type PrintoutLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Masked        bool                   `protobuf:"varint,2,opt,name=masked,proto3" json:"masked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrintoutLine) Reset() {
+	*x = PrintoutLine{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintoutLine) ProtoMessage() {}
+
+func (x *PrintoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintoutLine.ProtoReflect.Descriptor instead.
+func (*PrintoutLine) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PrintoutLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PrintoutLine) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+type TextLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Masked        bool                   `protobuf:"varint,2,opt,name=masked,proto3" json:"masked,omitempty"`
+	Bold          bool                   `protobuf:"varint,3,opt,name=bold,proto3" json:"bold,omitempty"`
+	Invers        bool                   `protobuf:"varint,4,opt,name=invers,proto3" json:"invers,omitempty"`
+	Center        bool                   `protobuf:"varint,5,opt,name=center,proto3" json:"center,omitempty"`
+	FontNumber    int32                  `protobuf:"varint,6,opt,name=font_number,json=fontNumber,proto3" json:"font_number,omitempty"`
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Width         int32                  `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Big           bool                   `protobuf:"varint,9,opt,name=big,proto3" json:"big,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextLine) Reset() {
+	*x = TextLine{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextLine) ProtoMessage() {}
+
+func (x *TextLine) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextLine.ProtoReflect.Descriptor instead.
+func (*TextLine) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TextLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextLine) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+func (x *TextLine) GetBold() bool {
+	if x != nil {
+		return x.Bold
+	}
+	return false
+}
+
+func (x *TextLine) GetInvers() bool {
+	if x != nil {
+		return x.Invers
+	}
+	return false
+}
+
+func (x *TextLine) GetCenter() bool {
+	if x != nil {
+		return x.Center
+	}
+	return false
+}
+
+func (x *TextLine) GetFontNumber() int32 {
+	if x != nil {
+		return x.FontNumber
+	}
+	return 0
+}
+
+func (x *TextLine) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TextLine) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TextLine) GetBig() bool {
+	if x != nil {
+		return x.Big
+	}
+	return false
+}
+
+type Separator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Char          string                 `protobuf:"bytes,1,opt,name=char,proto3" json:"char,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Separator) Reset() {
+	*x = Separator{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Separator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Separator) ProtoMessage() {}
+
+func (x *Separator) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Separator.ProtoReflect.Descriptor instead.
+func (*Separator) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Separator) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Center        bool                   `protobuf:"varint,2,opt,name=center,proto3" json:"center,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Image) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Image) GetCenter() bool {
+	if x != nil {
+		return x.Center
+	}
+	return false
+}
+
+type Barcode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "ean8", "ean13" or "code128"
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Hri           string                 `protobuf:"bytes,5,opt,name=hri,proto3" json:"hri,omitempty"` // "none", "above", "below" or "both"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Barcode) Reset() {
+	*x = Barcode{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Barcode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Barcode) ProtoMessage() {}
+
+func (x *Barcode) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Barcode.ProtoReflect.Descriptor instead.
+func (*Barcode) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Barcode) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Barcode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Barcode) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Barcode) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Barcode) GetHri() string {
+	if x != nil {
+		return x.Hri
+	}
+	return ""
+}
+
+type QRCode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Size            int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ErrorCorrection string                 `protobuf:"bytes,3,opt,name=error_correction,json=errorCorrection,proto3" json:"error_correction,omitempty"` // "L", "M", "Q" or "H"
+	Hri             bool                   `protobuf:"varint,4,opt,name=hri,proto3" json:"hri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QRCode) Reset() {
+	*x = QRCode{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRCode) ProtoMessage() {}
+
+func (x *QRCode) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRCode.ProtoReflect.Descriptor instead.
+func (*QRCode) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QRCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QRCode) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *QRCode) GetErrorCorrection() string {
+	if x != nil {
+		return x.ErrorCorrection
+	}
+	return ""
+}
+
+func (x *QRCode) GetHri() bool {
+	if x != nil {
+		return x.Hri
+	}
+	return false
+}
+
+type Line struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Line_Line
+	//	*Line_Textline
+	//	*Line_Separator
+	//	*Line_Image
+	//	*Line_Barcode
+	//	*Line_Qrcode
+	Kind          isLine_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Line) Reset() {
+	*x = Line{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Line) ProtoMessage() {}
+
+func (x *Line) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Line.ProtoReflect.Descriptor instead.
+func (*Line) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Line) GetKind() isLine_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Line) GetLine() *PrintoutLine {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Line); ok {
+			return x.Line
+		}
+	}
+	return nil
+}
+
+func (x *Line) GetTextline() *TextLine {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Textline); ok {
+			return x.Textline
+		}
+	}
+	return nil
+}
+
+func (x *Line) GetSeparator() *Separator {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Separator); ok {
+			return x.Separator
+		}
+	}
+	return nil
+}
+
+func (x *Line) GetImage() *Image {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Image); ok {
+			return x.Image
+		}
+	}
+	return nil
+}
+
+func (x *Line) GetBarcode() *Barcode {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Barcode); ok {
+			return x.Barcode
+		}
+	}
+	return nil
+}
+
+func (x *Line) GetQrcode() *QRCode {
+	if x != nil {
+		if x, ok := x.Kind.(*Line_Qrcode); ok {
+			return x.Qrcode
+		}
+	}
+	return nil
+}
+
+type isLine_Kind interface {
+	isLine_Kind()
+}
+
+type Line_Line struct {
+	Line *PrintoutLine `protobuf:"bytes,1,opt,name=line,proto3,oneof"`
+}
+
+type Line_Textline struct {
+	Textline *TextLine `protobuf:"bytes,2,opt,name=textline,proto3,oneof"`
+}
+
+type Line_Separator struct {
+	Separator *Separator `protobuf:"bytes,3,opt,name=separator,proto3,oneof"`
+}
+
+type Line_Image struct {
+	Image *Image `protobuf:"bytes,4,opt,name=image,proto3,oneof"`
+}
+
+type Line_Barcode struct {
+	Barcode *Barcode `protobuf:"bytes,5,opt,name=barcode,proto3,oneof"`
+}
+
+type Line_Qrcode struct {
+	Qrcode *QRCode `protobuf:"bytes,6,opt,name=qrcode,proto3,oneof"`
+}
+
+func (*Line_Line) isLine_Kind() {}
+
+func (*Line_Textline) isLine_Kind() {}
+
+func (*Line_Separator) isLine_Kind() {}
+
+func (*Line_Image) isLine_Kind() {}
+
+func (*Line_Barcode) isLine_Kind() {}
+
+func (*Line_Qrcode) isLine_Kind() {}
+
+type Receipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Payments      []*Payment             `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments,omitempty"`
+	Summary       *Summary               `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	PrintoutLines []*Line                `protobuf:"bytes,4,rep,name=printout_lines,json=printoutLines,proto3" json:"printout_lines,omitempty"`
+	Buyer         *Buyer                 `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	SystemInfo    *SystemInfo            `protobuf:"bytes,6,opt,name=system_info,json=systemInfo,proto3" json:"system_info,omitempty"`
+	DeviceControl *DeviceControl         `protobuf:"bytes,7,opt,name=device_control,json=deviceControl,proto3" json:"device_control,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Receipt) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Receipt) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Receipt) GetSummary() *Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Receipt) GetPrintoutLines() []*Line {
+	if x != nil {
+		return x.PrintoutLines
+	}
+	return nil
+}
+
+func (x *Receipt) GetBuyer() *Buyer {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Receipt) GetSystemInfo() *SystemInfo {
+	if x != nil {
+		return x.SystemInfo
+	}
+	return nil
+}
+
+func (x *Receipt) GetDeviceControl() *DeviceControl {
+	if x != nil {
+		return x.DeviceControl
+	}
+	return nil
+}
+
+type Info struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	CopyCount     int32                  `protobuf:"varint,2,opt,name=copy_count,json=copyCount,proto3" json:"copy_count,omitempty"`
+	DateOfSell    string                 `protobuf:"bytes,3,opt,name=date_of_sell,json=dateOfSell,proto3" json:"date_of_sell,omitempty"`
+	DateOfPayment string                 `protobuf:"bytes,4,opt,name=date_of_payment,json=dateOfPayment,proto3" json:"date_of_payment,omitempty"`
+	PaymentForm   string                 `protobuf:"bytes,5,opt,name=payment_form,json=paymentForm,proto3" json:"payment_form,omitempty"`
+	Paid          string                 `protobuf:"bytes,6,opt,name=paid,proto3" json:"paid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Info) Reset() {
+	*x = Info{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info) ProtoMessage() {}
+
+func (x *Info) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info.ProtoReflect.Descriptor instead.
+func (*Info) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Info) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Info) GetCopyCount() int32 {
+	if x != nil {
+		return x.CopyCount
+	}
+	return 0
+}
+
+func (x *Info) GetDateOfSell() string {
+	if x != nil {
+		return x.DateOfSell
+	}
+	return ""
+}
+
+func (x *Info) GetDateOfPayment() string {
+	if x != nil {
+		return x.DateOfPayment
+	}
+	return ""
+}
+
+func (x *Info) GetPaymentForm() string {
+	if x != nil {
+		return x.PaymentForm
+	}
+	return ""
+}
+
+func (x *Info) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+type TransactionSide struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PrintInfo     string                 `protobuf:"bytes,2,opt,name=print_info,json=printInfo,proto3" json:"print_info,omitempty"` // "place_for_signature", "name_and_place_for_signature" or "none"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSide) Reset() {
+	*x = TransactionSide{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSide) ProtoMessage() {}
+
+func (x *TransactionSide) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSide.ProtoReflect.Descriptor instead.
+func (*TransactionSide) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransactionSide) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransactionSide) GetPrintInfo() string {
+	if x != nil {
+		return x.PrintInfo
+	}
+	return ""
+}
+
+type InvoiceOptions struct {
+	state                                      protoimpl.MessageState `protogen:"open.v1"`
+	SkipDescriptionValueToPay                  bool                   `protobuf:"varint,1,opt,name=skip_description_value_to_pay,json=skipDescriptionValueToPay,proto3" json:"skip_description_value_to_pay,omitempty"`
+	SkipBlockGrossValueInAccountingTax         bool                   `protobuf:"varint,2,opt,name=skip_block_gross_value_in_accounting_tax,json=skipBlockGrossValueInAccountingTax,proto3" json:"skip_block_gross_value_in_accounting_tax,omitempty"`
+	BuyerBold                                  bool                   `protobuf:"varint,3,opt,name=buyer_bold,json=buyerBold,proto3" json:"buyer_bold,omitempty"`
+	SellerBold                                 bool                   `protobuf:"varint,4,opt,name=seller_bold,json=sellerBold,proto3" json:"seller_bold,omitempty"`
+	BuyerNipBold                               bool                   `protobuf:"varint,5,opt,name=buyer_nip_bold,json=buyerNipBold,proto3" json:"buyer_nip_bold,omitempty"`
+	SellerNipBold                              bool                   `protobuf:"varint,6,opt,name=seller_nip_bold,json=sellerNipBold,proto3" json:"seller_nip_bold,omitempty"`
+	PrintLabelDescriptionSymbolInInvoiceHeader bool                   `protobuf:"varint,7,opt,name=print_label_description_symbol_in_invoice_header,json=printLabelDescriptionSymbolInInvoiceHeader,proto3" json:"print_label_description_symbol_in_invoice_header,omitempty"`
+	PrintPositionNumberInInvoiceHeader         bool                   `protobuf:"varint,8,opt,name=print_position_number_in_invoice_header,json=printPositionNumberInInvoiceHeader,proto3" json:"print_position_number_in_invoice_header,omitempty"`
+	PrintPositionNumberInvoice                 bool                   `protobuf:"varint,9,opt,name=print_position_number_invoice,json=printPositionNumberInvoice,proto3" json:"print_position_number_invoice,omitempty"`
+	ToPayLabelBeforeAcountingTaxBlock          bool                   `protobuf:"varint,10,opt,name=to_pay_label_before_acounting_tax_block,json=toPayLabelBeforeAcountingTaxBlock,proto3" json:"to_pay_label_before_acounting_tax_block,omitempty"`
+	PrintCentsInWords                          bool                   `protobuf:"varint,11,opt,name=print_cents_in_words,json=printCentsInWords,proto3" json:"print_cents_in_words,omitempty"`
+	DontPrintSellDateIfEqualCreateDate         bool                   `protobuf:"varint,12,opt,name=dont_print_sell_date_if_equal_create_date,json=dontPrintSellDateIfEqualCreateDate,proto3" json:"dont_print_sell_date_if_equal_create_date,omitempty"`
+	DontPrintSellerDataInHeader                bool                   `protobuf:"varint,13,opt,name=dont_print_seller_data_in_header,json=dontPrintSellerDataInHeader,proto3" json:"dont_print_seller_data_in_header,omitempty"`
+	DontPrintSellItemsDescription              bool                   `protobuf:"varint,14,opt,name=dont_print_sell_items_description,json=dontPrintSellItemsDescription,proto3" json:"dont_print_sell_items_description,omitempty"`
+	EnablePaymentForm                          bool                   `protobuf:"varint,15,opt,name=enable_payment_form,json=enablePaymentForm,proto3" json:"enable_payment_form,omitempty"`
+	DontPrintCustomerData                      bool                   `protobuf:"varint,16,opt,name=dont_print_customer_data,json=dontPrintCustomerData,proto3" json:"dont_print_customer_data,omitempty"`
+	PrintPaydInCash                            bool                   `protobuf:"varint,17,opt,name=print_payd_in_cash,json=printPaydInCash,proto3" json:"print_payd_in_cash,omitempty"`
+	SkipSellerLabel                            bool                   `protobuf:"varint,18,opt,name=skip_seller_label,json=skipSellerLabel,proto3" json:"skip_seller_label,omitempty"`
+	PrintInvoiceTaxLabel                       bool                   `protobuf:"varint,19,opt,name=print_invoice_tax_label,json=printInvoiceTaxLabel,proto3" json:"print_invoice_tax_label,omitempty"`
+	unknownFields                              protoimpl.UnknownFields
+	sizeCache                                  protoimpl.SizeCache
+}
+
+func (x *InvoiceOptions) Reset() {
+	*x = InvoiceOptions{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceOptions) ProtoMessage() {}
+
+func (x *InvoiceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceOptions.ProtoReflect.Descriptor instead.
+func (*InvoiceOptions) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InvoiceOptions) GetSkipDescriptionValueToPay() bool {
+	if x != nil {
+		return x.SkipDescriptionValueToPay
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetSkipBlockGrossValueInAccountingTax() bool {
+	if x != nil {
+		return x.SkipBlockGrossValueInAccountingTax
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetBuyerBold() bool {
+	if x != nil {
+		return x.BuyerBold
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetSellerBold() bool {
+	if x != nil {
+		return x.SellerBold
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetBuyerNipBold() bool {
+	if x != nil {
+		return x.BuyerNipBold
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetSellerNipBold() bool {
+	if x != nil {
+		return x.SellerNipBold
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintLabelDescriptionSymbolInInvoiceHeader() bool {
+	if x != nil {
+		return x.PrintLabelDescriptionSymbolInInvoiceHeader
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintPositionNumberInInvoiceHeader() bool {
+	if x != nil {
+		return x.PrintPositionNumberInInvoiceHeader
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintPositionNumberInvoice() bool {
+	if x != nil {
+		return x.PrintPositionNumberInvoice
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetToPayLabelBeforeAcountingTaxBlock() bool {
+	if x != nil {
+		return x.ToPayLabelBeforeAcountingTaxBlock
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintCentsInWords() bool {
+	if x != nil {
+		return x.PrintCentsInWords
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetDontPrintSellDateIfEqualCreateDate() bool {
+	if x != nil {
+		return x.DontPrintSellDateIfEqualCreateDate
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetDontPrintSellerDataInHeader() bool {
+	if x != nil {
+		return x.DontPrintSellerDataInHeader
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetDontPrintSellItemsDescription() bool {
+	if x != nil {
+		return x.DontPrintSellItemsDescription
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetEnablePaymentForm() bool {
+	if x != nil {
+		return x.EnablePaymentForm
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetDontPrintCustomerData() bool {
+	if x != nil {
+		return x.DontPrintCustomerData
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintPaydInCash() bool {
+	if x != nil {
+		return x.PrintPaydInCash
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetSkipSellerLabel() bool {
+	if x != nil {
+		return x.SkipSellerLabel
+	}
+	return false
+}
+
+func (x *InvoiceOptions) GetPrintInvoiceTaxLabel() bool {
+	if x != nil {
+		return x.PrintInvoiceTaxLabel
+	}
+	return false
+}
+
+type AdditionalInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Bold          bool                   `protobuf:"varint,2,opt,name=bold,proto3" json:"bold,omitempty"`
+	Justification string                 `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"` // "left", "center" or "right"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalInfo) Reset() {
+	*x = AdditionalInfo{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalInfo) ProtoMessage() {}
+
+func (x *AdditionalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalInfo.ProtoReflect.Descriptor instead.
+func (*AdditionalInfo) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AdditionalInfo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AdditionalInfo) GetBold() bool {
+	if x != nil {
+		return x.Bold
+	}
+	return false
+}
+
+func (x *AdditionalInfo) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Info           *Info                  `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Buyer          *Buyer                 `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Recipient      *TransactionSide       `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Seller         *TransactionSide       `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	Options        *InvoiceOptions        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	Items          []*Item                `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Payments       []*Payment             `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments,omitempty"`
+	Summary        *Summary               `protobuf:"bytes,8,opt,name=summary,proto3" json:"summary,omitempty"`
+	PrintoutLines  []*Line                `protobuf:"bytes,9,rep,name=printout_lines,json=printoutLines,proto3" json:"printout_lines,omitempty"`
+	AdditionalInfo []*AdditionalInfo      `protobuf:"bytes,10,rep,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty"`
+	DeviceControl  *DeviceControl         `protobuf:"bytes,11,opt,name=device_control,json=deviceControl,proto3" json:"device_control,omitempty"`
+	SystemInfo     *SystemInfo            `protobuf:"bytes,12,opt,name=system_info,json=systemInfo,proto3" json:"system_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Invoice) GetInfo() *Info {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *Invoice) GetBuyer() *Buyer {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Invoice) GetRecipient() *TransactionSide {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *Invoice) GetSeller() *TransactionSide {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Invoice) GetOptions() *InvoiceOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Invoice) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Invoice) GetSummary() *Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Invoice) GetPrintoutLines() []*Line {
+	if x != nil {
+		return x.PrintoutLines
+	}
+	return nil
+}
+
+func (x *Invoice) GetAdditionalInfo() []*AdditionalInfo {
+	if x != nil {
+		return x.AdditionalInfo
+	}
+	return nil
+}
+
+func (x *Invoice) GetDeviceControl() *DeviceControl {
+	if x != nil {
+		return x.DeviceControl
+	}
+	return nil
+}
+
+func (x *Invoice) GetSystemInfo() *SystemInfo {
+	if x != nil {
+		return x.SystemInfo
+	}
+	return nil
+}
+
+type PrintoutOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WithoutHeader    bool                   `protobuf:"varint,1,opt,name=without_header,json=withoutHeader,proto3" json:"without_header,omitempty"`
+	LeftMargin       bool                   `protobuf:"varint,2,opt,name=left_margin,json=leftMargin,proto3" json:"left_margin,omitempty"`
+	CopyOnly         bool                   `protobuf:"varint,3,opt,name=copy_only,json=copyOnly,proto3" json:"copy_only,omitempty"`
+	FiscalMarginsOff bool                   `protobuf:"varint,4,opt,name=fiscal_margins_off,json=fiscalMarginsOff,proto3" json:"fiscal_margins_off,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PrintoutOptions) Reset() {
+	*x = PrintoutOptions{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintoutOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintoutOptions) ProtoMessage() {}
+
+func (x *PrintoutOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintoutOptions.ProtoReflect.Descriptor instead.
+func (*PrintoutOptions) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PrintoutOptions) GetWithoutHeader() bool {
+	if x != nil {
+		return x.WithoutHeader
+	}
+	return false
+}
+
+func (x *PrintoutOptions) GetLeftMargin() bool {
+	if x != nil {
+		return x.LeftMargin
+	}
+	return false
+}
+
+func (x *PrintoutOptions) GetCopyOnly() bool {
+	if x != nil {
+		return x.CopyOnly
+	}
+	return false
+}
+
+func (x *PrintoutOptions) GetFiscalMarginsOff() bool {
+	if x != nil {
+		return x.FiscalMarginsOff
+	}
+	return false
+}
+
+type Printout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *PrintoutOptions       `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Lines         []*Line                `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	EDocument     *EDocument             `protobuf:"bytes,3,opt,name=e_document,json=eDocument,proto3" json:"e_document,omitempty"`
+	SystemInfo    *SystemInfo            `protobuf:"bytes,4,opt,name=system_info,json=systemInfo,proto3" json:"system_info,omitempty"`
+	DeviceControl *DeviceControl         `protobuf:"bytes,5,opt,name=device_control,json=deviceControl,proto3" json:"device_control,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Printout) Reset() {
+	*x = Printout{}
+	mi := &file_novitus_v1_novitus_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Printout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Printout) ProtoMessage() {}
+
+func (x *Printout) ProtoReflect() protoreflect.Message {
+	mi := &file_novitus_v1_novitus_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Printout.ProtoReflect.Descriptor instead.
+func (*Printout) Descriptor() ([]byte, []int) {
+	return file_novitus_v1_novitus_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Printout) GetOptions() *PrintoutOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Printout) GetLines() []*Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Printout) GetEDocument() *EDocument {
+	if x != nil {
+		return x.EDocument
+	}
+	return nil
+}
+
+func (x *Printout) GetSystemInfo() *SystemInfo {
+	if x != nil {
+		return x.SystemInfo
+	}
+	return nil
+}
+
+func (x *Printout) GetDeviceControl() *DeviceControl {
+	if x != nil {
+		return x.DeviceControl
+	}
+	return nil
+}
+
+var File_novitus_v1_novitus_proto protoreflect.FileDescriptor
+
+const file_novitus_v1_novitus_proto_rawDesc = "" +
+	"\n" +
+	"\x18novitus/v1/novitus.proto\x12\n" +
+	"novitus.v1\"]\n" +
+	"\x12SendReceiptRequest\x12-\n" +
+	"\areceipt\x18\x01 \x01(\v2\x13.novitus.v1.ReceiptR\areceipt\x12\x18\n" +
+	"\aconfirm\x18\x02 \x01(\bR\aconfirm\"]\n" +
+	"\x12SendInvoiceRequest\x12-\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x13.novitus.v1.InvoiceR\ainvoice\x12\x18\n" +
+	"\aconfirm\x18\x02 \x01(\bR\aconfirm\"a\n" +
+	"\x13SendPrintoutRequest\x120\n" +
+	"\bprintout\x18\x01 \x01(\v2\x14.novitus.v1.PrintoutR\bprintout\x12\x18\n" +
+	"\aconfirm\x18\x02 \x01(\bR\aconfirm\"M\n" +
+	"\vDocumentRef\x12\x1f\n" +
+	"\vobject_type\x18\x01 \x01(\tR\n" +
+	"objectType\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"w\n" +
+	"\x14WatchDocumentRequest\x12\x1f\n" +
+	"\vobject_type\x18\x01 \x01(\tR\n" +
+	"objectType\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vinterval_ms\x18\x03 \x01(\x05R\n" +
+	"intervalMs\"\x17\n" +
+	"\x15GetQueueStatusRequest\"9\n" +
+	"\vQueueStatus\x12*\n" +
+	"\x11requests_in_queue\x18\x01 \x01(\x05R\x0frequestsInQueue\"\x12\n" +
+	"\x10GetDeviceRequest\"U\n" +
+	"\x05Error\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\x8f\x01\n" +
+	"\aRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"e_document\x18\x03 \x01(\tR\teDocument\x12\x14\n" +
+	"\x05jpkid\x18\x04 \x01(\tR\x05jpkid\x12'\n" +
+	"\x05error\x18\x05 \x01(\v2\x11.novitus.v1.ErrorR\x05error\"I\n" +
+	"\x06Device\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12'\n" +
+	"\x05error\x18\x02 \x01(\v2\x11.novitus.v1.ErrorR\x05error\"k\n" +
+	"\x0eDocumentStatus\x12*\n" +
+	"\x06device\x18\x01 \x01(\v2\x12.novitus.v1.DeviceR\x06device\x12-\n" +
+	"\arequest\x18\x02 \x01(\v2\x13.novitus.v1.RequestR\arequest\"\xb7\x02\n" +
+	"\n" +
+	"DeviceInfo\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x1a\n" +
+	"\bfirmware\x18\x02 \x01(\tR\bfirmware\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12#\n" +
+	"\runique_number\x18\x04 \x01(\tR\funiqueNumber\x12!\n" +
+	"\ffiscal_state\x18\x05 \x01(\tR\vfiscalState\x12\x14\n" +
+	"\x05paper\x18\x06 \x01(\tR\x05paper\x12\x1d\n" +
+	"\n" +
+	"cover_open\x18\a \x01(\bR\tcoverOpen\x12\x14\n" +
+	"\x05clock\x18\b \x01(\tR\x05clock\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12'\n" +
+	"\x05error\x18\n" +
+	" \x01(\v2\x11.novitus.v1.ErrorR\x05error\"N\n" +
+	"\x0eDiscountMarkup\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x93\x01\n" +
+	"\aSummary\x12C\n" +
+	"\x0fdiscount_markup\x18\x01 \x01(\v2\x1a.novitus.v1.DiscountMarkupR\x0ediscountMarkup\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\tR\x05total\x12\x15\n" +
+	"\x06pay_in\x18\x03 \x01(\tR\x05payIn\x12\x16\n" +
+	"\x06change\x18\x04 \x01(\tR\x06change\"v\n" +
+	"\tEDocument\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12&\n" +
+	"\x0fprint_send_mode\x18\x03 \x01(\tR\rprintSendMode\"\xc5\x01\n" +
+	"\x05Buyer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\aid_type\x18\x02 \x01(\tR\x06idType\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"label_type\x18\x04 \x01(\tR\tlabelType\x12\x18\n" +
+	"\aaddress\x18\x05 \x03(\tR\aaddress\x12\x10\n" +
+	"\x03nip\x18\x06 \x01(\tR\x03nip\x124\n" +
+	"\n" +
+	"e_document\x18\a \x01(\v2\x15.novitus.v1.EDocumentR\teDocument\"u\n" +
+	"\n" +
+	"SystemInfo\x12!\n" +
+	"\fcashier_name\x18\x01 \x01(\tR\vcashierName\x12\x1f\n" +
+	"\vcash_number\x18\x02 \x01(\tR\n" +
+	"cashNumber\x12#\n" +
+	"\rsystem_number\x18\x03 \x01(\tR\fsystemNumber\"}\n" +
+	"\rDeviceControl\x12\x1f\n" +
+	"\vopen_drawer\x18\x01 \x01(\bR\n" +
+	"openDrawer\x12.\n" +
+	"\x13feed_after_printout\x18\x02 \x01(\bR\x11feedAfterPrintout\x12\x1b\n" +
+	"\tpaper_cut\x18\x03 \x01(\tR\bpaperCut\"\x86\x02\n" +
+	"\aArticle\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03ptu\x18\x02 \x01(\tR\x03ptu\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\tR\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12C\n" +
+	"\x0fdiscount_markup\x18\a \x01(\v2\x1a.novitus.v1.DiscountMarkupR\x0ediscountMarkup\x12\x12\n" +
+	"\x04code\x18\b \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\"S\n" +
+	"\aAdvance\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03ptu\x18\x02 \x01(\tR\x03ptu\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"i\n" +
+	"\tContainer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\tR\bquantity\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\xa9\x02\n" +
+	"\x04Item\x12/\n" +
+	"\aarticle\x18\x01 \x01(\v2\x13.novitus.v1.ArticleH\x00R\aarticle\x12/\n" +
+	"\aadvance\x18\x02 \x01(\v2\x13.novitus.v1.AdvanceH\x00R\aadvance\x12<\n" +
+	"\x0eadvance_return\x18\x03 \x01(\v2\x13.novitus.v1.AdvanceH\x00R\radvanceReturn\x125\n" +
+	"\tcontainer\x18\x04 \x01(\v2\x15.novitus.v1.ContainerH\x00R\tcontainer\x12B\n" +
+	"\x10container_return\x18\x05 \x01(\v2\x15.novitus.v1.ContainerH\x00R\x0fcontainerReturnB\x06\n" +
+	"\x04kind\"\x1c\n" +
+	"\x04Cash\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\x9b\x01\n" +
+	"\bCurrency\x12\x16\n" +
+	"\x06course\x18\x01 \x01(\tR\x06course\x12%\n" +
+	"\x0ecurrency_value\x18\x02 \x01(\tR\rcurrencyValue\x12\x1f\n" +
+	"\vlocal_value\x18\x03 \x01(\tR\n" +
+	"localValue\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_change\x18\x05 \x01(\bR\bisChange\"@\n" +
+	"\x14TypicalPaymentMethod\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x8b\x05\n" +
+	"\aPayment\x12&\n" +
+	"\x04cash\x18\x01 \x01(\v2\x10.novitus.v1.CashH\x00R\x04cash\x122\n" +
+	"\bcurrency\x18\x02 \x01(\v2\x14.novitus.v1.CurrencyH\x00R\bcurrency\x126\n" +
+	"\x04card\x18\x03 \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x04card\x12:\n" +
+	"\x06cheque\x18\x04 \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x06cheque\x12:\n" +
+	"\x06coupon\x18\x05 \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x06coupon\x128\n" +
+	"\x05other\x18\x06 \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x05other\x12:\n" +
+	"\x06credit\x18\a \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x06credit\x12<\n" +
+	"\aaccount\x18\b \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\aaccount\x12>\n" +
+	"\btransfer\x18\t \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\btransfer\x12:\n" +
+	"\x06mobile\x18\n" +
+	" \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\x06mobile\x12<\n" +
+	"\avoucher\x18\v \x01(\v2 .novitus.v1.TypicalPaymentMethodH\x00R\avoucherB\x06\n" +
+	"\x04kind\":\n" +
+	"\fPrintoutLine\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06masked\x18\x02 \x01(\bR\x06masked\"\xdb\x01\n" +
+	"\bTextLine\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06masked\x18\x02 \x01(\bR\x06masked\x12\x12\n" +
+	"\x04bold\x18\x03 \x01(\bR\x04bold\x12\x16\n" +
+	"\x06invers\x18\x04 \x01(\bR\x06invers\x12\x16\n" +
+	"\x06center\x18\x05 \x01(\bR\x06center\x12\x1f\n" +
+	"\vfont_number\x18\x06 \x01(\x05R\n" +
+	"fontNumber\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x14\n" +
+	"\x05width\x18\b \x01(\x05R\x05width\x12\x10\n" +
+	"\x03big\x18\t \x01(\bR\x03big\"\x1f\n" +
+	"\tSeparator\x12\x12\n" +
+	"\x04char\x18\x01 \x01(\tR\x04char\"7\n" +
+	"\x05Image\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x16\n" +
+	"\x06center\x18\x02 \x01(\bR\x06center\"q\n" +
+	"\aBarcode\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x10\n" +
+	"\x03hri\x18\x05 \x01(\tR\x03hri\"m\n" +
+	"\x06QRCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12)\n" +
+	"\x10error_correction\x18\x03 \x01(\tR\x0ferrorCorrection\x12\x10\n" +
+	"\x03hri\x18\x04 \x01(\bR\x03hri\"\xb3\x02\n" +
+	"\x04Line\x12.\n" +
+	"\x04line\x18\x01 \x01(\v2\x18.novitus.v1.PrintoutLineH\x00R\x04line\x122\n" +
+	"\btextline\x18\x02 \x01(\v2\x14.novitus.v1.TextLineH\x00R\btextline\x125\n" +
+	"\tseparator\x18\x03 \x01(\v2\x15.novitus.v1.SeparatorH\x00R\tseparator\x12)\n" +
+	"\x05image\x18\x04 \x01(\v2\x11.novitus.v1.ImageH\x00R\x05image\x12/\n" +
+	"\abarcode\x18\x05 \x01(\v2\x13.novitus.v1.BarcodeH\x00R\abarcode\x12,\n" +
+	"\x06qrcode\x18\x06 \x01(\v2\x12.novitus.v1.QRCodeH\x00R\x06qrcodeB\x06\n" +
+	"\x04kind\"\xee\x02\n" +
+	"\aReceipt\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.novitus.v1.ItemR\x05items\x12/\n" +
+	"\bpayments\x18\x02 \x03(\v2\x13.novitus.v1.PaymentR\bpayments\x12-\n" +
+	"\asummary\x18\x03 \x01(\v2\x13.novitus.v1.SummaryR\asummary\x127\n" +
+	"\x0eprintout_lines\x18\x04 \x03(\v2\x10.novitus.v1.LineR\rprintoutLines\x12'\n" +
+	"\x05buyer\x18\x05 \x01(\v2\x11.novitus.v1.BuyerR\x05buyer\x127\n" +
+	"\vsystem_info\x18\x06 \x01(\v2\x16.novitus.v1.SystemInfoR\n" +
+	"systemInfo\x12@\n" +
+	"\x0edevice_control\x18\a \x01(\v2\x19.novitus.v1.DeviceControlR\rdeviceControl\"\xbe\x01\n" +
+	"\x04Info\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x1d\n" +
+	"\n" +
+	"copy_count\x18\x02 \x01(\x05R\tcopyCount\x12 \n" +
+	"\fdate_of_sell\x18\x03 \x01(\tR\n" +
+	"dateOfSell\x12&\n" +
+	"\x0fdate_of_payment\x18\x04 \x01(\tR\rdateOfPayment\x12!\n" +
+	"\fpayment_form\x18\x05 \x01(\tR\vpaymentForm\x12\x12\n" +
+	"\x04paid\x18\x06 \x01(\tR\x04paid\"D\n" +
+	"\x0fTransactionSide\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"print_info\x18\x02 \x01(\tR\tprintInfo\"\x9a\t\n" +
+	"\x0eInvoiceOptions\x12@\n" +
+	"\x1dskip_description_value_to_pay\x18\x01 \x01(\bR\x19skipDescriptionValueToPay\x12T\n" +
+	"(skip_block_gross_value_in_accounting_tax\x18\x02 \x01(\bR\"skipBlockGrossValueInAccountingTax\x12\x1d\n" +
+	"\n" +
+	"buyer_bold\x18\x03 \x01(\bR\tbuyerBold\x12\x1f\n" +
+	"\vseller_bold\x18\x04 \x01(\bR\n" +
+	"sellerBold\x12$\n" +
+	"\x0ebuyer_nip_bold\x18\x05 \x01(\bR\fbuyerNipBold\x12&\n" +
+	"\x0fseller_nip_bold\x18\x06 \x01(\bR\rsellerNipBold\x12d\n" +
+	"0print_label_description_symbol_in_invoice_header\x18\a \x01(\bR*printLabelDescriptionSymbolInInvoiceHeader\x12S\n" +
+	"'print_position_number_in_invoice_header\x18\b \x01(\bR\"printPositionNumberInInvoiceHeader\x12A\n" +
+	"\x1dprint_position_number_invoice\x18\t \x01(\bR\x1aprintPositionNumberInvoice\x12R\n" +
+	"'to_pay_label_before_acounting_tax_block\x18\n" +
+	" \x01(\bR!toPayLabelBeforeAcountingTaxBlock\x12/\n" +
+	"\x14print_cents_in_words\x18\v \x01(\bR\x11printCentsInWords\x12U\n" +
+	")dont_print_sell_date_if_equal_create_date\x18\f \x01(\bR\"dontPrintSellDateIfEqualCreateDate\x12E\n" +
+	" dont_print_seller_data_in_header\x18\r \x01(\bR\x1bdontPrintSellerDataInHeader\x12H\n" +
+	"!dont_print_sell_items_description\x18\x0e \x01(\bR\x1ddontPrintSellItemsDescription\x12.\n" +
+	"\x13enable_payment_form\x18\x0f \x01(\bR\x11enablePaymentForm\x127\n" +
+	"\x18dont_print_customer_data\x18\x10 \x01(\bR\x15dontPrintCustomerData\x12+\n" +
+	"\x12print_payd_in_cash\x18\x11 \x01(\bR\x0fprintPaydInCash\x12*\n" +
+	"\x11skip_seller_label\x18\x12 \x01(\bR\x0fskipSellerLabel\x125\n" +
+	"\x17print_invoice_tax_label\x18\x13 \x01(\bR\x14printInvoiceTaxLabel\"^\n" +
+	"\x0eAdditionalInfo\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04bold\x18\x02 \x01(\bR\x04bold\x12$\n" +
+	"\rjustification\x18\x03 \x01(\tR\rjustification\"\xff\x04\n" +
+	"\aInvoice\x12$\n" +
+	"\x04info\x18\x01 \x01(\v2\x10.novitus.v1.InfoR\x04info\x12'\n" +
+	"\x05buyer\x18\x02 \x01(\v2\x11.novitus.v1.BuyerR\x05buyer\x129\n" +
+	"\trecipient\x18\x03 \x01(\v2\x1b.novitus.v1.TransactionSideR\trecipient\x123\n" +
+	"\x06seller\x18\x04 \x01(\v2\x1b.novitus.v1.TransactionSideR\x06seller\x124\n" +
+	"\aoptions\x18\x05 \x01(\v2\x1a.novitus.v1.InvoiceOptionsR\aoptions\x12&\n" +
+	"\x05items\x18\x06 \x03(\v2\x10.novitus.v1.ItemR\x05items\x12/\n" +
+	"\bpayments\x18\a \x03(\v2\x13.novitus.v1.PaymentR\bpayments\x12-\n" +
+	"\asummary\x18\b \x01(\v2\x13.novitus.v1.SummaryR\asummary\x127\n" +
+	"\x0eprintout_lines\x18\t \x03(\v2\x10.novitus.v1.LineR\rprintoutLines\x12C\n" +
+	"\x0fadditional_info\x18\n" +
+	" \x03(\v2\x1a.novitus.v1.AdditionalInfoR\x0eadditionalInfo\x12@\n" +
+	"\x0edevice_control\x18\v \x01(\v2\x19.novitus.v1.DeviceControlR\rdeviceControl\x127\n" +
+	"\vsystem_info\x18\f \x01(\v2\x16.novitus.v1.SystemInfoR\n" +
+	"systemInfo\"\xa4\x01\n" +
+	"\x0fPrintoutOptions\x12%\n" +
+	"\x0ewithout_header\x18\x01 \x01(\bR\rwithoutHeader\x12\x1f\n" +
+	"\vleft_margin\x18\x02 \x01(\bR\n" +
+	"leftMargin\x12\x1b\n" +
+	"\tcopy_only\x18\x03 \x01(\bR\bcopyOnly\x12,\n" +
+	"\x12fiscal_margins_off\x18\x04 \x01(\bR\x10fiscalMarginsOff\"\x9a\x02\n" +
+	"\bPrintout\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1b.novitus.v1.PrintoutOptionsR\aoptions\x12&\n" +
+	"\x05lines\x18\x02 \x03(\v2\x10.novitus.v1.LineR\x05lines\x124\n" +
+	"\n" +
+	"e_document\x18\x03 \x01(\v2\x15.novitus.v1.EDocumentR\teDocument\x127\n" +
+	"\vsystem_info\x18\x04 \x01(\v2\x16.novitus.v1.SystemInfoR\n" +
+	"systemInfo\x12@\n" +
+	"\x0edevice_control\x18\x05 \x01(\v2\x19.novitus.v1.DeviceControlR\rdeviceControl2\xa5\x05\n" +
+	"\rFiscalPrinter\x12I\n" +
+	"\vSendReceipt\x12\x1e.novitus.v1.SendReceiptRequest\x1a\x1a.novitus.v1.DocumentStatus\x12I\n" +
+	"\vSendInvoice\x12\x1e.novitus.v1.SendInvoiceRequest\x1a\x1a.novitus.v1.DocumentStatus\x12K\n" +
+	"\fSendPrintout\x12\x1f.novitus.v1.SendPrintoutRequest\x1a\x1a.novitus.v1.DocumentStatus\x12>\n" +
+	"\aConfirm\x12\x17.novitus.v1.DocumentRef\x1a\x1a.novitus.v1.DocumentStatus\x12H\n" +
+	"\x11GetDocumentStatus\x12\x17.novitus.v1.DocumentRef\x1a\x1a.novitus.v1.DocumentStatus\x12E\n" +
+	"\x0eDeleteDocument\x12\x17.novitus.v1.DocumentRef\x1a\x1a.novitus.v1.DocumentStatus\x12O\n" +
+	"\rWatchDocument\x12 .novitus.v1.WatchDocumentRequest\x1a\x1a.novitus.v1.DocumentStatus0\x01\x12L\n" +
+	"\x0eGetQueueStatus\x12!.novitus.v1.GetQueueStatusRequest\x1a\x17.novitus.v1.QueueStatus\x12A\n" +
+	"\tGetDevice\x12\x1c.novitus.v1.GetDeviceRequest\x1a\x16.novitus.v1.DeviceInfoB1Z/github.com/Hkozacz/novitus_gosdk/grpc/novituspbb\x06proto3"
+
+var (
+	file_novitus_v1_novitus_proto_rawDescOnce sync.Once
+	file_novitus_v1_novitus_proto_rawDescData []byte
+)
+
+func file_novitus_v1_novitus_proto_rawDescGZIP() []byte {
+	file_novitus_v1_novitus_proto_rawDescOnce.Do(func() {
+		file_novitus_v1_novitus_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_novitus_v1_novitus_proto_rawDesc), len(file_novitus_v1_novitus_proto_rawDesc)))
+	})
+	return file_novitus_v1_novitus_proto_rawDescData
+}
+
+var file_novitus_v1_novitus_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_novitus_v1_novitus_proto_goTypes = []any{
+	(*SendReceiptRequest)(nil),    // 0: novitus.v1.SendReceiptRequest
+	(*SendInvoiceRequest)(nil),    // 1: novitus.v1.SendInvoiceRequest
+	(*SendPrintoutRequest)(nil),   // 2: novitus.v1.SendPrintoutRequest
+	(*DocumentRef)(nil),           // 3: novitus.v1.DocumentRef
+	(*WatchDocumentRequest)(nil),  // 4: novitus.v1.WatchDocumentRequest
+	(*GetQueueStatusRequest)(nil), // 5: novitus.v1.GetQueueStatusRequest
+	(*QueueStatus)(nil),           // 6: novitus.v1.QueueStatus
+	(*GetDeviceRequest)(nil),      // 7: novitus.v1.GetDeviceRequest
+	(*Error)(nil),                 // 8: novitus.v1.Error
+	(*Request)(nil),               // 9: novitus.v1.Request
+	(*Device)(nil),                // 10: novitus.v1.Device
+	(*DocumentStatus)(nil),        // 11: novitus.v1.DocumentStatus
+	(*DeviceInfo)(nil),            // 12: novitus.v1.DeviceInfo
+	(*DiscountMarkup)(nil),        // 13: novitus.v1.DiscountMarkup
+	(*Summary)(nil),               // 14: novitus.v1.Summary
+	(*EDocument)(nil),             // 15: novitus.v1.EDocument
+	(*Buyer)(nil),                 // 16: novitus.v1.Buyer
+	(*SystemInfo)(nil),            // 17: novitus.v1.SystemInfo
+	(*DeviceControl)(nil),         // 18: novitus.v1.DeviceControl
+	(*Article)(nil),               // 19: novitus.v1.Article
+	(*Advance)(nil),               // 20: novitus.v1.Advance
+	(*Container)(nil),             // 21: novitus.v1.Container
+	(*Item)(nil),                  // 22: novitus.v1.Item
+	(*Cash)(nil),                  // 23: novitus.v1.Cash
+	(*Currency)(nil),              // 24: novitus.v1.Currency
+	(*TypicalPaymentMethod)(nil),  // 25: novitus.v1.TypicalPaymentMethod
+	(*Payment)(nil),               // 26: novitus.v1.Payment
+	(*PrintoutLine)(nil),          // 27: novitus.v1.PrintoutLine
+	(*TextLine)(nil),              // 28: novitus.v1.TextLine
+	(*Separator)(nil),             // 29: novitus.v1.Separator
+	(*Image)(nil),                 // 30: novitus.v1.Image
+	(*Barcode)(nil),               // 31: novitus.v1.Barcode
+	(*QRCode)(nil),                // 32: novitus.v1.QRCode
+	(*Line)(nil),                  // 33: novitus.v1.Line
+	(*Receipt)(nil),               // 34: novitus.v1.Receipt
+	(*Info)(nil),                  // 35: novitus.v1.Info
+	(*TransactionSide)(nil),       // 36: novitus.v1.TransactionSide
+	(*InvoiceOptions)(nil),        // 37: novitus.v1.InvoiceOptions
+	(*AdditionalInfo)(nil),        // 38: novitus.v1.AdditionalInfo
+	(*Invoice)(nil),               // 39: novitus.v1.Invoice
+	(*PrintoutOptions)(nil),       // 40: novitus.v1.PrintoutOptions
+	(*Printout)(nil),              // 41: novitus.v1.Printout
+}
+var file_novitus_v1_novitus_proto_depIdxs = []int32{
+	34, // 0: novitus.v1.SendReceiptRequest.receipt:type_name -> novitus.v1.Receipt
+	39, // 1: novitus.v1.SendInvoiceRequest.invoice:type_name -> novitus.v1.Invoice
+	41, // 2: novitus.v1.SendPrintoutRequest.printout:type_name -> novitus.v1.Printout
+	8,  // 3: novitus.v1.Request.error:type_name -> novitus.v1.Error
+	8,  // 4: novitus.v1.Device.error:type_name -> novitus.v1.Error
+	10, // 5: novitus.v1.DocumentStatus.device:type_name -> novitus.v1.Device
+	9,  // 6: novitus.v1.DocumentStatus.request:type_name -> novitus.v1.Request
+	8,  // 7: novitus.v1.DeviceInfo.error:type_name -> novitus.v1.Error
+	13, // 8: novitus.v1.Summary.discount_markup:type_name -> novitus.v1.DiscountMarkup
+	15, // 9: novitus.v1.Buyer.e_document:type_name -> novitus.v1.EDocument
+	13, // 10: novitus.v1.Article.discount_markup:type_name -> novitus.v1.DiscountMarkup
+	19, // 11: novitus.v1.Item.article:type_name -> novitus.v1.Article
+	20, // 12: novitus.v1.Item.advance:type_name -> novitus.v1.Advance
+	20, // 13: novitus.v1.Item.advance_return:type_name -> novitus.v1.Advance
+	21, // 14: novitus.v1.Item.container:type_name -> novitus.v1.Container
+	21, // 15: novitus.v1.Item.container_return:type_name -> novitus.v1.Container
+	23, // 16: novitus.v1.Payment.cash:type_name -> novitus.v1.Cash
+	24, // 17: novitus.v1.Payment.currency:type_name -> novitus.v1.Currency
+	25, // 18: novitus.v1.Payment.card:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 19: novitus.v1.Payment.cheque:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 20: novitus.v1.Payment.coupon:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 21: novitus.v1.Payment.other:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 22: novitus.v1.Payment.credit:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 23: novitus.v1.Payment.account:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 24: novitus.v1.Payment.transfer:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 25: novitus.v1.Payment.mobile:type_name -> novitus.v1.TypicalPaymentMethod
+	25, // 26: novitus.v1.Payment.voucher:type_name -> novitus.v1.TypicalPaymentMethod
+	27, // 27: novitus.v1.Line.line:type_name -> novitus.v1.PrintoutLine
+	28, // 28: novitus.v1.Line.textline:type_name -> novitus.v1.TextLine
+	29, // 29: novitus.v1.Line.separator:type_name -> novitus.v1.Separator
+	30, // 30: novitus.v1.Line.image:type_name -> novitus.v1.Image
+	31, // 31: novitus.v1.Line.barcode:type_name -> novitus.v1.Barcode
+	32, // 32: novitus.v1.Line.qrcode:type_name -> novitus.v1.QRCode
+	22, // 33: novitus.v1.Receipt.items:type_name -> novitus.v1.Item
+	26, // 34: novitus.v1.Receipt.payments:type_name -> novitus.v1.Payment
+	14, // 35: novitus.v1.Receipt.summary:type_name -> novitus.v1.Summary
+	33, // 36: novitus.v1.Receipt.printout_lines:type_name -> novitus.v1.Line
+	16, // 37: novitus.v1.Receipt.buyer:type_name -> novitus.v1.Buyer
+	17, // 38: novitus.v1.Receipt.system_info:type_name -> novitus.v1.SystemInfo
+	18, // 39: novitus.v1.Receipt.device_control:type_name -> novitus.v1.DeviceControl
+	35, // 40: novitus.v1.Invoice.info:type_name -> novitus.v1.Info
+	16, // 41: novitus.v1.Invoice.buyer:type_name -> novitus.v1.Buyer
+	36, // 42: novitus.v1.Invoice.recipient:type_name -> novitus.v1.TransactionSide
+	36, // 43: novitus.v1.Invoice.seller:type_name -> novitus.v1.TransactionSide
+	37, // 44: novitus.v1.Invoice.options:type_name -> novitus.v1.InvoiceOptions
+	22, // 45: novitus.v1.Invoice.items:type_name -> novitus.v1.Item
+	26, // 46: novitus.v1.Invoice.payments:type_name -> novitus.v1.Payment
+	14, // 47: novitus.v1.Invoice.summary:type_name -> novitus.v1.Summary
+	33, // 48: novitus.v1.Invoice.printout_lines:type_name -> novitus.v1.Line
+	38, // 49: novitus.v1.Invoice.additional_info:type_name -> novitus.v1.AdditionalInfo
+	18, // 50: novitus.v1.Invoice.device_control:type_name -> novitus.v1.DeviceControl
+	17, // 51: novitus.v1.Invoice.system_info:type_name -> novitus.v1.SystemInfo
+	40, // 52: novitus.v1.Printout.options:type_name -> novitus.v1.PrintoutOptions
+	33, // 53: novitus.v1.Printout.lines:type_name -> novitus.v1.Line
+	15, // 54: novitus.v1.Printout.e_document:type_name -> novitus.v1.EDocument
+	17, // 55: novitus.v1.Printout.system_info:type_name -> novitus.v1.SystemInfo
+	18, // 56: novitus.v1.Printout.device_control:type_name -> novitus.v1.DeviceControl
+	0,  // 57: novitus.v1.FiscalPrinter.SendReceipt:input_type -> novitus.v1.SendReceiptRequest
+	1,  // 58: novitus.v1.FiscalPrinter.SendInvoice:input_type -> novitus.v1.SendInvoiceRequest
+	2,  // 59: novitus.v1.FiscalPrinter.SendPrintout:input_type -> novitus.v1.SendPrintoutRequest
+	3,  // 60: novitus.v1.FiscalPrinter.Confirm:input_type -> novitus.v1.DocumentRef
+	3,  // 61: novitus.v1.FiscalPrinter.GetDocumentStatus:input_type -> novitus.v1.DocumentRef
+	3,  // 62: novitus.v1.FiscalPrinter.DeleteDocument:input_type -> novitus.v1.DocumentRef
+	4,  // 63: novitus.v1.FiscalPrinter.WatchDocument:input_type -> novitus.v1.WatchDocumentRequest
+	5,  // 64: novitus.v1.FiscalPrinter.GetQueueStatus:input_type -> novitus.v1.GetQueueStatusRequest
+	7,  // 65: novitus.v1.FiscalPrinter.GetDevice:input_type -> novitus.v1.GetDeviceRequest
+	11, // 66: novitus.v1.FiscalPrinter.SendReceipt:output_type -> novitus.v1.DocumentStatus
+	11, // 67: novitus.v1.FiscalPrinter.SendInvoice:output_type -> novitus.v1.DocumentStatus
+	11, // 68: novitus.v1.FiscalPrinter.SendPrintout:output_type -> novitus.v1.DocumentStatus
+	11, // 69: novitus.v1.FiscalPrinter.Confirm:output_type -> novitus.v1.DocumentStatus
+	11, // 70: novitus.v1.FiscalPrinter.GetDocumentStatus:output_type -> novitus.v1.DocumentStatus
+	11, // 71: novitus.v1.FiscalPrinter.DeleteDocument:output_type -> novitus.v1.DocumentStatus
+	11, // 72: novitus.v1.FiscalPrinter.WatchDocument:output_type -> novitus.v1.DocumentStatus
+	6,  // 73: novitus.v1.FiscalPrinter.GetQueueStatus:output_type -> novitus.v1.QueueStatus
+	12, // 74: novitus.v1.FiscalPrinter.GetDevice:output_type -> novitus.v1.DeviceInfo
+	66, // [66:75] is the sub-list for method output_type
+	57, // [57:66] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
+}
+
+func init() { file_novitus_v1_novitus_proto_init() }
+func file_novitus_v1_novitus_proto_init() {
+	if File_novitus_v1_novitus_proto != nil {
+		return
+	}
+	file_novitus_v1_novitus_proto_msgTypes[22].OneofWrappers = []any{
+		(*Item_Article)(nil),
+		(*Item_Advance)(nil),
+		(*Item_AdvanceReturn)(nil),
+		(*Item_Container)(nil),
+		(*Item_ContainerReturn)(nil),
+	}
+	file_novitus_v1_novitus_proto_msgTypes[26].OneofWrappers = []any{
+		(*Payment_Cash)(nil),
+		(*Payment_Currency)(nil),
+		(*Payment_Card)(nil),
+		(*Payment_Cheque)(nil),
+		(*Payment_Coupon)(nil),
+		(*Payment_Other)(nil),
+		(*Payment_Credit)(nil),
+		(*Payment_Account)(nil),
+		(*Payment_Transfer)(nil),
+		(*Payment_Mobile)(nil),
+		(*Payment_Voucher)(nil),
+	}
+	file_novitus_v1_novitus_proto_msgTypes[33].OneofWrappers = []any{
+		(*Line_Line)(nil),
+		(*Line_Textline)(nil),
+		(*Line_Separator)(nil),
+		(*Line_Image)(nil),
+		(*Line_Barcode)(nil),
+		(*Line_Qrcode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_novitus_v1_novitus_proto_rawDesc), len(file_novitus_v1_novitus_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   42,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_novitus_v1_novitus_proto_goTypes,
+		DependencyIndexes: file_novitus_v1_novitus_proto_depIdxs,
+		MessageInfos:      file_novitus_v1_novitus_proto_msgTypes,
+	}.Build()
+	File_novitus_v1_novitus_proto = out.File
+	file_novitus_v1_novitus_proto_goTypes = nil
+	file_novitus_v1_novitus_proto_depIdxs = nil
+}
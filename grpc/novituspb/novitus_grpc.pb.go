@@ -0,0 +1,440 @@
+// Fiscal printing over gRPC, backed by the Novitus API.
+//
+// Field names mirror the JSON of the Novitus API, so messages convert to
+// the SDK types one to one. Amounts are decimal strings, e.g. "19.99".
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: novitus/v1/novitus.proto
+
+package novituspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FiscalPrinter_SendReceipt_FullMethodName       = "/novitus.v1.FiscalPrinter/SendReceipt"
+	FiscalPrinter_SendInvoice_FullMethodName       = "/novitus.v1.FiscalPrinter/SendInvoice"
+	FiscalPrinter_SendPrintout_FullMethodName      = "/novitus.v1.FiscalPrinter/SendPrintout"
+	FiscalPrinter_Confirm_FullMethodName           = "/novitus.v1.FiscalPrinter/Confirm"
+	FiscalPrinter_GetDocumentStatus_FullMethodName = "/novitus.v1.FiscalPrinter/GetDocumentStatus"
+	FiscalPrinter_DeleteDocument_FullMethodName    = "/novitus.v1.FiscalPrinter/DeleteDocument"
+	FiscalPrinter_WatchDocument_FullMethodName     = "/novitus.v1.FiscalPrinter/WatchDocument"
+	FiscalPrinter_GetQueueStatus_FullMethodName    = "/novitus.v1.FiscalPrinter/GetQueueStatus"
+	FiscalPrinter_GetDevice_FullMethodName         = "/novitus.v1.FiscalPrinter/GetDevice"
+)
+
+// FiscalPrinterClient is the client API for FiscalPrinter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FiscalPrinterClient interface {
+	// Send* validate the document, send it and confirm it if confirm is set.
+	SendReceipt(ctx context.Context, in *SendReceiptRequest, opts ...grpc.CallOption) (*DocumentStatus, error)
+	SendInvoice(ctx context.Context, in *SendInvoiceRequest, opts ...grpc.CallOption) (*DocumentStatus, error)
+	SendPrintout(ctx context.Context, in *SendPrintoutRequest, opts ...grpc.CallOption) (*DocumentStatus, error)
+	Confirm(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error)
+	GetDocumentStatus(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error)
+	DeleteDocument(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error)
+	// WatchDocument streams the status every time it changes, until the
+	// request is DONE or ERROR.
+	WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DocumentStatus], error)
+	GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*QueueStatus, error)
+	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceInfo, error)
+}
+
+type fiscalPrinterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFiscalPrinterClient(cc grpc.ClientConnInterface) FiscalPrinterClient {
+	return &fiscalPrinterClient{cc}
+}
+
+func (c *fiscalPrinterClient) SendReceipt(ctx context.Context, in *SendReceiptRequest, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_SendReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) SendInvoice(ctx context.Context, in *SendInvoiceRequest, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_SendInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) SendPrintout(ctx context.Context, in *SendPrintoutRequest, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_SendPrintout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) Confirm(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_Confirm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) GetDocumentStatus(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_GetDocumentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) DeleteDocument(ctx context.Context, in *DocumentRef, opts ...grpc.CallOption) (*DocumentStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_DeleteDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DocumentStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FiscalPrinter_ServiceDesc.Streams[0], FiscalPrinter_WatchDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDocumentRequest, DocumentStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FiscalPrinter_WatchDocumentClient = grpc.ServerStreamingClient[DocumentStatus]
+
+func (c *fiscalPrinterClient) GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*QueueStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStatus)
+	err := c.cc.Invoke(ctx, FiscalPrinter_GetQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPrinterClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceInfo)
+	err := c.cc.Invoke(ctx, FiscalPrinter_GetDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FiscalPrinterServer is the server API for FiscalPrinter service.
+// All implementations must embed UnimplementedFiscalPrinterServer
+// for forward compatibility.
+type FiscalPrinterServer interface {
+	// Send* validate the document, send it and confirm it if confirm is set.
+	SendReceipt(context.Context, *SendReceiptRequest) (*DocumentStatus, error)
+	SendInvoice(context.Context, *SendInvoiceRequest) (*DocumentStatus, error)
+	SendPrintout(context.Context, *SendPrintoutRequest) (*DocumentStatus, error)
+	Confirm(context.Context, *DocumentRef) (*DocumentStatus, error)
+	GetDocumentStatus(context.Context, *DocumentRef) (*DocumentStatus, error)
+	DeleteDocument(context.Context, *DocumentRef) (*DocumentStatus, error)
+	// WatchDocument streams the status every time it changes, until the
+	// request is DONE or ERROR.
+	WatchDocument(*WatchDocumentRequest, grpc.ServerStreamingServer[DocumentStatus]) error
+	GetQueueStatus(context.Context, *GetQueueStatusRequest) (*QueueStatus, error)
+	GetDevice(context.Context, *GetDeviceRequest) (*DeviceInfo, error)
+	mustEmbedUnimplementedFiscalPrinterServer()
+}
+
+// UnimplementedFiscalPrinterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFiscalPrinterServer struct{}
+
+func (UnimplementedFiscalPrinterServer) SendReceipt(context.Context, *SendReceiptRequest) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendReceipt not implemented")
+}
+func (UnimplementedFiscalPrinterServer) SendInvoice(context.Context, *SendInvoiceRequest) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendInvoice not implemented")
+}
+func (UnimplementedFiscalPrinterServer) SendPrintout(context.Context, *SendPrintoutRequest) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendPrintout not implemented")
+}
+func (UnimplementedFiscalPrinterServer) Confirm(context.Context, *DocumentRef) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method Confirm not implemented")
+}
+func (UnimplementedFiscalPrinterServer) GetDocumentStatus(context.Context, *DocumentRef) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDocumentStatus not implemented")
+}
+func (UnimplementedFiscalPrinterServer) DeleteDocument(context.Context, *DocumentRef) (*DocumentStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDocument not implemented")
+}
+func (UnimplementedFiscalPrinterServer) WatchDocument(*WatchDocumentRequest, grpc.ServerStreamingServer[DocumentStatus]) error {
+	return status.Error(codes.Unimplemented, "method WatchDocument not implemented")
+}
+func (UnimplementedFiscalPrinterServer) GetQueueStatus(context.Context, *GetQueueStatusRequest) (*QueueStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueueStatus not implemented")
+}
+func (UnimplementedFiscalPrinterServer) GetDevice(context.Context, *GetDeviceRequest) (*DeviceInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedFiscalPrinterServer) mustEmbedUnimplementedFiscalPrinterServer() {}
+func (UnimplementedFiscalPrinterServer) testEmbeddedByValue()                       {}
+
+// UnsafeFiscalPrinterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FiscalPrinterServer will
+// result in compilation errors.
+type UnsafeFiscalPrinterServer interface {
+	mustEmbedUnimplementedFiscalPrinterServer()
+}
+
+func RegisterFiscalPrinterServer(s grpc.ServiceRegistrar, srv FiscalPrinterServer) {
+	// If the following call panics, it indicates UnimplementedFiscalPrinterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FiscalPrinter_ServiceDesc, srv)
+}
+
+func _FiscalPrinter_SendReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).SendReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_SendReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).SendReceipt(ctx, req.(*SendReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_SendInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).SendInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_SendInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).SendInvoice(ctx, req.(*SendInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_SendPrintout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPrintoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).SendPrintout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_SendPrintout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).SendPrintout(ctx, req.(*SendPrintoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_Confirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).Confirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_Confirm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).Confirm(ctx, req.(*DocumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_GetDocumentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).GetDocumentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_GetDocumentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).GetDocumentStatus(ctx, req.(*DocumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).DeleteDocument(ctx, req.(*DocumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_WatchDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FiscalPrinterServer).WatchDocument(m, &grpc.GenericServerStream[WatchDocumentRequest, DocumentStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FiscalPrinter_WatchDocumentServer = grpc.ServerStreamingServer[DocumentStatus]
+
+func _FiscalPrinter_GetQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).GetQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_GetQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).GetQueueStatus(ctx, req.(*GetQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPrinter_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPrinterServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FiscalPrinter_GetDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPrinterServer).GetDevice(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FiscalPrinter_ServiceDesc is the grpc.ServiceDesc for FiscalPrinter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FiscalPrinter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "novitus.v1.FiscalPrinter",
+	HandlerType: (*FiscalPrinterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendReceipt",
+			Handler:    _FiscalPrinter_SendReceipt_Handler,
+		},
+		{
+			MethodName: "SendInvoice",
+			Handler:    _FiscalPrinter_SendInvoice_Handler,
+		},
+		{
+			MethodName: "SendPrintout",
+			Handler:    _FiscalPrinter_SendPrintout_Handler,
+		},
+		{
+			MethodName: "Confirm",
+			Handler:    _FiscalPrinter_Confirm_Handler,
+		},
+		{
+			MethodName: "GetDocumentStatus",
+			Handler:    _FiscalPrinter_GetDocumentStatus_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _FiscalPrinter_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "GetQueueStatus",
+			Handler:    _FiscalPrinter_GetQueueStatus_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _FiscalPrinter_GetDevice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDocument",
+			Handler:       _FiscalPrinter_WatchDocument_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "novitus/v1/novitus.proto",
+}
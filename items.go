@@ -0,0 +1,158 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// decodeItem turns an entry of Receipt.Items or Invoice.Items into one of
+// *Article, *Advance, *AdvanceReturn, *Container or *ContainerReturn.
+// Entries are expected in the wire form used by the API, e.g.
+// map[string]interface{}{"article": Article{...}} or
+// map[string]Article{"article": {...}}, but bare item structs and entries
+// decoded from JSON are accepted as well.
+func decodeItem(item interface{}) (interface{}, error) {
+	switch v := item.(type) {
+	case *Article, *Advance, *AdvanceReturn, *Container, *ContainerReturn:
+		return v, nil
+	case Article:
+		return &v, nil
+	case Advance:
+		return &v, nil
+	case AdvanceReturn:
+		return &v, nil
+	case Container:
+		return &v, nil
+	case ContainerReturn:
+		return &v, nil
+	}
+	if value := reflect.ValueOf(item); value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String {
+		if value.Len() != 1 {
+			return nil, fmt.Errorf("item must have exactly one key, got %d", value.Len())
+		}
+		entry := value.MapRange()
+		entry.Next()
+		return decodeItemValue(entry.Key().String(), entry.Value().Interface())
+	}
+	return nil, fmt.Errorf("unsupported item type %T", item)
+}
+
+func decodeItemValue(key string, value interface{}) (interface{}, error) {
+	if _, isMap := value.(map[string]interface{}); !isMap {
+		if decoded, err := decodeItem(value); err == nil {
+			if itemKind(decoded) != key {
+				return nil, fmt.Errorf("item key %q does not match value of type %T", key, value)
+			}
+			return decoded, nil
+		}
+	}
+	var target interface{}
+	switch key {
+	case "article":
+		target = &Article{}
+	case "advance":
+		target = &Advance{}
+	case "advance_return":
+		target = &AdvanceReturn{}
+	case "container":
+		target = &Container{}
+	case "container_return":
+		target = &ContainerReturn{}
+	default:
+		return nil, fmt.Errorf("unsupported item kind %q", key)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s item: %w", key, err)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("failed to decode %s item: %w", key, err)
+	}
+	return target, nil
+}
+
+// validateItems validates every entry of Receipt.Items or Invoice.Items,
+// including article discounts and markups against the article value.
+func validateItems(items []interface{}) error {
+	for idx, item := range items {
+		decoded, err := decodeItem(item)
+		if err != nil {
+			return fmt.Errorf("item %d: %w", idx, err)
+		}
+		if err := decoded.(Document).Validate(); err != nil {
+			return fmt.Errorf("item %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func itemKind(item interface{}) string {
+	switch item.(type) {
+	case *Article:
+		return "article"
+	case *Advance:
+		return "advance"
+	case *AdvanceReturn:
+		return "advance_return"
+	case *Container:
+		return "container"
+	case *ContainerReturn:
+		return "container_return"
+	}
+	return ""
+}
+
+// decodePayment turns an entry of Receipt.Payments or Invoice.Payments
+// into one of *Cash, *Currency or *TypicalPaymentMethod. Entries are keyed
+// like items, e.g. {"cash": Cash{...}}; any other key is treated as a
+// typical payment method named after the key unless the value names it.
+func decodePayment(payment interface{}) (interface{}, error) {
+	switch v := payment.(type) {
+	case *Cash, *Currency, *TypicalPaymentMethod:
+		return v, nil
+	case Cash:
+		return &v, nil
+	case Currency:
+		return &v, nil
+	case TypicalPaymentMethod:
+		return &v, nil
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("payment must have exactly one key, got %d", len(v))
+		}
+		for key, value := range v {
+			var target interface{}
+			switch key {
+			case "cash":
+				target = &Cash{}
+			case "currency":
+				target = &Currency{}
+			default:
+				target = &TypicalPaymentMethod{}
+			}
+			if _, isMap := value.(map[string]interface{}); !isMap {
+				decoded, err := decodePayment(value)
+				if err != nil {
+					return nil, err
+				}
+				target = decoded
+			} else {
+				raw, err := json.Marshal(value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to encode %s payment: %w", key, err)
+				}
+				if err := json.Unmarshal(raw, target); err != nil {
+					return nil, fmt.Errorf("failed to decode %s payment: %w", key, err)
+				}
+			}
+			if method, ok := target.(*TypicalPaymentMethod); ok && method.Name == "" {
+				named := *method
+				named.Name = key
+				target = &named
+			}
+			return target, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported payment type %T", payment)
+}
@@ -0,0 +1,124 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// JPKID is the id of the JPK (Standard Audit File) record of a request.
+// The API returns it as a number, older versions as a string; both are
+// accepted.
+type JPKID string
+
+func (j *JPKID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*j = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("invalid jpkid: %w", err)
+		}
+		*j = JPKID(s)
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("invalid jpkid: %w", err)
+	}
+	*j = JPKID(number.String())
+	return nil
+}
+
+func (j JPKID) String() string {
+	return string(j)
+}
+
+// Int returns the id as a number, the type Request.JPKID had before it
+// accepted string ids.
+func (j JPKID) Int() (int, error) {
+	if j == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(string(j))
+	if err != nil {
+		return 0, fmt.Errorf("jpkid %q is not a number: %w", string(j), err)
+	}
+	return id, nil
+}
+
+// IsZero reports whether no JPK record is associated with the request.
+func (j JPKID) IsZero() bool {
+	return j == "" || j == "0"
+}
+
+type JPKTaxTotal struct {
+	PTU   string `json:"ptu"`
+	Rate  string `json:"rate"`
+	Net   string `json:"net"`
+	VAT   string `json:"vat"`
+	Gross string `json:"gross"`
+}
+
+type JPKItem struct {
+	Name     string `json:"name"`
+	PTU      string `json:"ptu"`
+	Quantity string `json:"quantity"`
+	Price    string `json:"price"`
+	Value    string `json:"value"`
+}
+
+type JPKPayment struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// JPKRecord is the JPK record returned by GetJPK. Experimental: the API
+// description only documents the jpkid of a request, so the fields below
+// may change.
+type JPKRecord struct {
+	Id             JPKID         `json:"id"`
+	DocumentType   string        `json:"document_type"`   // e.g. "receipt", "invoice"
+	DocumentNumber string        `json:"document_number"` // Number of the document in the printer
+	UniqueNumber   string        `json:"unique_number"`   // Unique number of the fiscal printer
+	CreatedAt      string        `json:"created_at"`
+	Items          []JPKItem     `json:"items"`
+	TaxTotals      []JPKTaxTotal `json:"tax_totals"`
+	Payments       []JPKPayment  `json:"payments"`
+	Total          string        `json:"total"`
+	// Raw holds the record exactly as returned by the API, including
+	// fields not mapped above, for archiving.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *JPKRecord) UnmarshalJSON(data []byte) error {
+	type plain JPKRecord
+	var record plain
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+	*r = JPKRecord(record)
+	r.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// GetRequestJPK fetches the JPK record associated with a finished request.
+// Experimental, see GetJPK.
+func (n *NovitusClient) GetRequestJPK(objectType, requestId string) (JPKRecord, error) {
+	status, err := n.CheckDocumentStatus(objectType, requestId)
+	if err != nil {
+		return JPKRecord{}, fmt.Errorf("failed to get jpk: %w", err)
+	}
+	if status.JPKID.IsZero() {
+		return JPKRecord{}, fmt.Errorf("no jpk record for request %s, request status is %s", requestId, status.Status)
+	}
+	jpkResponse, err := n.GetJPK(status.JPKID)
+	if err != nil {
+		return JPKRecord{}, err
+	}
+	return jpkResponse.JPK, nil
+}
@@ -0,0 +1,74 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestJPKIDUnmarshal(t *testing.T) {
+	tests := []struct {
+		json   string
+		want   JPKID
+		number int
+		zero   bool
+	}{
+		{`{"jpkid":17}`, "17", 17, false},
+		{`{"jpkid":"17"}`, "17", 17, false},
+		{`{"jpkid":0}`, "0", 0, true},
+		{`{"jpkid":null}`, "", 0, true},
+		{`{}`, "", 0, true},
+	}
+	for _, tt := range tests {
+		var request Request
+		if err := json.Unmarshal([]byte(tt.json), &request); err != nil {
+			t.Errorf("%s: %v", tt.json, err)
+			continue
+		}
+		if request.JPKID != tt.want || request.JPKID.IsZero() != tt.zero {
+			t.Errorf("%s: got %q, zero %v", tt.json, request.JPKID, request.JPKID.IsZero())
+		}
+		if number, err := request.JPKID.Int(); err != nil || number != tt.number {
+			t.Errorf("%s: Int() = %d, %v", tt.json, number, err)
+		}
+	}
+	var request Request
+	if err := json.Unmarshal([]byte(`{"jpkid":true}`), &request); err == nil {
+		t.Error("expected an error for a boolean jpkid")
+	}
+	if _, err := JPKID("A-1").Int(); err == nil {
+		t.Error("expected an error for a jpkid that is not a number")
+	}
+}
+
+func TestGetRequestJPK(t *testing.T) {
+	client, f := newFakeClient(t)
+	record := `{"id":7,"document_type":"receipt","total":"1.00","extra":"kept"}`
+	f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+		switch r.URL.Path {
+		case "/api/v1/receipt/done":
+			fmt.Fprint(w, `{"request":{"id":"done","status":"DONE","jpkid":7}}`)
+		case "/api/v1/receipt/stored":
+			fmt.Fprint(w, `{"request":{"id":"stored","status":"STORED","jpkid":0}}`)
+		case "/api/v1/jpk/7":
+			fmt.Fprintf(w, `{"jpk":%s}`, record)
+		default:
+			return false
+		}
+		return true
+	})
+	jpk, err := client.GetRequestJPK("receipt", "done")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jpk.Id != "7" || jpk.DocumentType != "receipt" || jpk.Total != "1.00" || string(jpk.Raw) != record {
+		t.Errorf("got %+v", jpk)
+	}
+	if _, err := client.GetRequestJPK("receipt", "stored"); err == nil {
+		t.Error("expected an error for a request without a jpk record")
+	}
+	if _, err := client.GetJPK(""); err == nil {
+		t.Error("expected an error for an empty jpk id")
+	}
+}
@@ -0,0 +1,38 @@
+package novitus_gosdk
+
+import "time"
+
+// ClientOption configures optional behaviour of a NovitusClient.
+type ClientOption func(*NovitusClient)
+
+// WithBackpressure makes SendDocument check the printer queue depth before
+// sending, see Backpressure.
+func WithBackpressure(backpressure Backpressure) ClientOption {
+	return func(n *NovitusClient) {
+		if backpressure.Mode == "" {
+			backpressure.Mode = BackpressureBlock
+		}
+		if backpressure.CacheFor <= 0 {
+			backpressure.CacheFor = time.Second
+		}
+		if backpressure.Delay <= 0 {
+			backpressure.Delay = 500 * time.Millisecond
+		}
+		if backpressure.MaxQueue <= 0 {
+			backpressure.MaxQueue = 10
+		}
+		if backpressure.MaxWait <= 0 {
+			backpressure.MaxWait = 30 * time.Second
+		}
+		n.backpressure = &backpressure
+	}
+}
+
+// WithLazyToken makes NewNovitusClient skip obtaining a token when none is
+// given, so a client can be created while the printer is offline. The
+// token is obtained by the first request instead.
+func WithLazyToken() ClientOption {
+	return func(n *NovitusClient) {
+		n.lazyToken = true
+	}
+}
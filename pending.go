@@ -0,0 +1,240 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	ErrDocumentCancelled = errors.New("document was cancelled")
+	ErrDocumentConfirmed = errors.New("document was already confirmed")
+)
+
+// The automatic cancel retries a failed attempt up to pendingMaxAttempts
+// times, waiting pendingRetryDelay, doubled after every attempt up to
+// pendingMaxRetryDelay. A failed Confirm or Cancel leaves the caller at
+// least pendingGracePeriod to retry before the document is cancelled.
+var (
+	pendingRetryDelay    = time.Second
+	pendingMaxRetryDelay = 30 * time.Second
+	pendingMaxAttempts   = 10
+	pendingGracePeriod   = 10 * time.Second
+)
+
+type PendingState string
+
+const (
+	PendingStateStored    PendingState = "stored"    // Sent, waiting for Confirm or Cancel
+	PendingStateConfirmed PendingState = "confirmed" // Confirmed and queued for printing
+	PendingStateCancelled PendingState = "cancelled" // Deleted by Cancel or by the timeout
+)
+
+// PendingDocument is a document sent to the printer but not confirmed yet,
+// e.g. a receipt waiting for the card terminal. It is not printed until
+// Confirm is called; if neither Confirm nor Cancel is called before the
+// timeout, it is deleted so it never blocks the queue.
+type PendingDocument struct {
+	ObjectType string
+	RequestId  string
+
+	client   *NovitusClient
+	mu       sync.Mutex
+	state    PendingState
+	busy     bool // Confirm or Cancel in progress
+	deadline time.Time
+	timer    *time.Timer
+	retry    time.Duration // Delay before the next automatic cancel attempt
+	attempts int           // Failed automatic cancel attempts
+	err      error         // Error of the last automatic cancel attempt, if it failed
+}
+
+// SendPending sends the document without confirming it. It is deleted
+// automatically after timeout unless confirmed or cancelled before; a
+// zero timeout disables the automatic cancel.
+func (n *NovitusClient) SendPending(documentType string, document Document, timeout time.Duration) (*PendingDocument, error) {
+	sendDocumentResponse, err := n.SendDocument(documentType, document)
+	if err != nil {
+		return nil, err
+	}
+	pending := &PendingDocument{
+		ObjectType: documentType,
+		RequestId:  sendDocumentResponse.Request.Id,
+		client:     n,
+		state:      PendingStateStored,
+	}
+	if timeout > 0 {
+		pending.deadline = time.Now().Add(timeout)
+		pending.timer = time.AfterFunc(timeout, pending.expire)
+	}
+	return pending, nil
+}
+
+func (n *NovitusClient) SendReceiptPending(receipt *Receipt, timeout time.Duration) (*PendingDocument, error) {
+	pending, err := n.SendPending("receipt", receipt, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send receipt: %w", err)
+	}
+	return pending, nil
+}
+
+func (n *NovitusClient) SendInvoicePending(invoice *Invoice, timeout time.Duration) (*PendingDocument, error) {
+	pending, err := n.SendPending("invoice", invoice, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send invoice: %w", err)
+	}
+	return pending, nil
+}
+
+func (p *PendingDocument) State() PendingState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.state
+}
+
+// Err returns the error of the last automatic cancel attempt, if it
+// failed. Failed attempts are retried until the document is deleted,
+// confirmed or cancelled, but not after a 4xx answer, once the client is
+// closed or after pendingMaxAttempts attempts; the document then stays
+// stored until Confirm or Cancel is called.
+func (p *PendingDocument) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Confirm confirms the document so the printer prints it.
+func (p *PendingDocument) Confirm() (SendDocumentResponse, error) {
+	if err := p.begin(); err != nil {
+		return SendDocumentResponse{}, err
+	}
+	confirmResponse, err := p.client.Confirm(p.ObjectType, p.RequestId)
+	p.finish(err, PendingStateConfirmed)
+	if err != nil {
+		return SendDocumentResponse{}, err
+	}
+	return confirmResponse, nil
+}
+
+// Cancel deletes the document from the printer queue.
+func (p *PendingDocument) Cancel() (DeleteDocumentResponse, error) {
+	if err := p.begin(); err != nil {
+		return DeleteDocumentResponse{}, err
+	}
+	deleteResponse, err := p.client.DeleteDocument(p.ObjectType, p.RequestId)
+	p.finish(err, PendingStateCancelled)
+	if err != nil {
+		return DeleteDocumentResponse{}, err
+	}
+	return deleteResponse, nil
+}
+
+func (p *PendingDocument) Status() (CheckDocumentStatusResponse, error) {
+	return p.client.CheckDocumentStatus(p.ObjectType, p.RequestId)
+}
+
+// Wait waits until the confirmed document is printed or failed.
+func (p *PendingDocument) Wait(ctx context.Context, interval time.Duration) (CheckDocumentStatusResponse, error) {
+	if state := p.State(); state != PendingStateConfirmed {
+		return CheckDocumentStatusResponse{}, fmt.Errorf("document is %s, only confirmed documents can be waited for", state)
+	}
+	return p.client.WaitForDocument(ctx, p.ObjectType, p.RequestId, interval)
+}
+
+// begin marks a Confirm or Cancel as in progress and stops the timeout.
+func (p *PendingDocument) begin() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case p.state == PendingStateCancelled:
+		return ErrDocumentCancelled
+	case p.state == PendingStateConfirmed:
+		return ErrDocumentConfirmed
+	case p.busy:
+		return fmt.Errorf("another operation on document %s is in progress", p.RequestId)
+	}
+	p.busy = true
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	return nil
+}
+
+// finish records the result of Confirm or Cancel. If it failed, the
+// document stays pending and the timeout is armed again, leaving at least
+// pendingGracePeriod to retry.
+func (p *PendingDocument) finish(err error, state PendingState) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.busy = false
+	if err == nil {
+		p.state = state
+		return
+	}
+	if p.timer != nil {
+		p.timer.Reset(max(time.Until(p.deadline), pendingGracePeriod))
+	}
+}
+
+func (p *PendingDocument) expire() {
+	p.mu.Lock()
+	if p.state != PendingStateStored || p.busy {
+		p.mu.Unlock()
+		return
+	}
+	p.busy = true
+	p.mu.Unlock()
+
+	state, err := p.cancelExpired()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.busy = false
+	if err != nil {
+		p.attempts++
+		p.err = fmt.Errorf("failed to cancel expired document: %w", err)
+		if p.state == PendingStateStored && p.attempts < pendingMaxAttempts && pendingRetryable(err) {
+			p.retry = min(max(p.retry*2, pendingRetryDelay), pendingMaxRetryDelay)
+			p.timer.Reset(p.retry)
+		}
+		return
+	}
+	p.state = state
+	p.err = nil
+}
+
+// cancelExpired deletes the document unless it was confirmed in the
+// meantime, e.g. by another process, and returns its new state.
+func (p *PendingDocument) cancelExpired() (PendingState, error) {
+	if p.client.closed() {
+		return "", ErrClientClosed
+	}
+	status, err := p.client.CheckDocumentStatus(p.ObjectType, p.RequestId)
+	var statusErr *StatusError
+	switch {
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		return PendingStateCancelled, nil // Deleted already
+	case err != nil:
+		return "", err
+	case status.Request.Status != RequestStatusStored:
+		return PendingStateConfirmed, nil
+	}
+	if _, err := p.client.DeleteDocument(p.ObjectType, p.RequestId); err != nil {
+		return "", err
+	}
+	return PendingStateCancelled, nil
+}
+
+// pendingRetryable reports whether a failed automatic cancel is worth
+// retrying: not after a 4xx answer other than 429 Too Many Requests, nor
+// once the client is closed.
+func pendingRetryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode < http.StatusInternalServerError && statusErr.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return !errors.Is(err, ErrClientClosed)
+}
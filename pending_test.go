@@ -0,0 +1,211 @@
+package novitus_gosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func shortPendingDelays(t *testing.T) {
+	retry, maxRetry, grace := pendingRetryDelay, pendingMaxRetryDelay, pendingGracePeriod
+	pendingRetryDelay, pendingMaxRetryDelay, pendingGracePeriod = 5*time.Millisecond, 20*time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() {
+		pendingRetryDelay, pendingMaxRetryDelay, pendingGracePeriod = retry, maxRetry, grace
+	})
+}
+
+// failCalls makes the first n calls with the given method fail.
+func failCalls(f *fakeNovitus, method string, n int32) *atomic.Int32 {
+	var calls atomic.Int32
+	f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method != method || calls.Add(1) > n {
+			return false
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"busy"}}`)
+		return true
+	})
+	return &calls
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPendingConfirm(t *testing.T) {
+	client, f := newFakeClient(t)
+	pending, err := client.SendReceiptPending(sampleReceipt(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pending.Wait(t.Context(), time.Millisecond); err == nil {
+		t.Error("expected Wait to fail before Confirm")
+	}
+	if _, err := pending.Confirm(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pending.Cancel(); !errors.Is(err, ErrDocumentConfirmed) {
+		t.Errorf("Cancel() = %v", err)
+	}
+	status, err := pending.Wait(t.Context(), time.Millisecond)
+	if err != nil || status.Request.Status != RequestStatusDone {
+		t.Errorf("Wait() = %s, %v", status.Request.Status, err)
+	}
+	if f.count("DELETE /api/v1/receipt/req1") != 0 {
+		t.Error("confirmed document was deleted")
+	}
+}
+
+func TestPendingExpire(t *testing.T) {
+	shortPendingDelays(t)
+	client, f := newFakeClient(t)
+	deletes := failCalls(f, http.MethodDelete, 3)
+	pending, err := client.SendReceiptPending(sampleReceipt(), time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return pending.State() == PendingStateCancelled })
+	if got := deletes.Load(); got != 4 {
+		t.Errorf("delete attempts = %d, want 4", got)
+	}
+	if pending.Err() != nil {
+		t.Errorf("Err() = %v after a successful retry", pending.Err())
+	}
+	if _, err := pending.Confirm(); !errors.Is(err, ErrDocumentCancelled) {
+		t.Errorf("Confirm() = %v", err)
+	}
+}
+
+func TestPendingConfirmAfterFailedExpire(t *testing.T) {
+	shortPendingDelays(t)
+	pendingRetryDelay, pendingMaxRetryDelay = time.Hour, time.Hour
+	client, f := newFakeClient(t)
+	failCalls(f, http.MethodDelete, 1)
+	pending, err := client.SendReceiptPending(sampleReceipt(), time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return pending.Err() != nil })
+	if pending.State() != PendingStateStored {
+		t.Fatalf("state = %s", pending.State())
+	}
+	if _, err := pending.Confirm(); err != nil {
+		t.Fatal(err)
+	}
+	if pending.State() != PendingStateConfirmed {
+		t.Errorf("state = %s", pending.State())
+	}
+}
+
+func TestPendingGracePeriod(t *testing.T) {
+	shortPendingDelays(t)
+	client, f := newFakeClient(t)
+	pending, err := client.SendReceiptPending(sampleReceipt(), 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failCalls(f, http.MethodPut, 1)
+	time.Sleep(5 * time.Millisecond)
+	if _, err := pending.Confirm(); err == nil {
+		t.Fatal("expected the first Confirm to fail")
+	}
+	// The deadline passes while the caller retries, but the grace
+	// period keeps the document.
+	time.Sleep(15 * time.Millisecond)
+	if _, err := pending.Confirm(); err != nil {
+		t.Fatalf("retried Confirm() = %v, state %s", err, pending.State())
+	}
+	if f.count("DELETE /api/v1/receipt/req1") != 0 {
+		t.Error("document was deleted during the grace period")
+	}
+}
+
+func TestPendingExpireConfirmedElsewhere(t *testing.T) {
+	shortPendingDelays(t)
+	client, f := newFakeClient(t)
+	pending, err := client.SendReceiptPending(sampleReceipt(), 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Another process confirms the document.
+	if _, err := client.Confirm("receipt", pending.RequestId); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return pending.State() != PendingStateStored })
+	if pending.State() != PendingStateConfirmed {
+		t.Errorf("state = %s, want confirmed", pending.State())
+	}
+	if f.count("DELETE /api/v1/receipt/req1") != 0 {
+		t.Error("confirmed document was deleted")
+	}
+}
+
+func TestPendingExpireStops(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		close   bool
+		deletes int32
+	}{
+		{"client error", http.StatusBadRequest, false, 1},
+		{"server errors", http.StatusInternalServerError, false, 3},
+		{"closed client", http.StatusInternalServerError, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shortPendingDelays(t)
+			attempts := pendingMaxAttempts
+			pendingMaxAttempts = 3
+			t.Cleanup(func() { pendingMaxAttempts = attempts })
+			client, f := newFakeClient(t)
+			var deletes atomic.Int32
+			f.setHandle(func(w http.ResponseWriter, r *http.Request) bool {
+				if r.Method != http.MethodDelete {
+					return false
+				}
+				deletes.Add(1)
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"exception":{"code":1,"description":"no"}}`)
+				return true
+			})
+			if tt.close {
+				defer client.Close()
+			}
+			pending, err := client.SendReceiptPending(sampleReceipt(), 5*time.Millisecond)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.close {
+				client.Close()
+			}
+			waitFor(t, func() bool { return pending.Err() != nil })
+			time.Sleep(100 * time.Millisecond)
+			if got := deletes.Load(); got != tt.deletes {
+				t.Errorf("delete attempts = %d, want %d", got, tt.deletes)
+			}
+			if pending.State() != PendingStateStored {
+				t.Errorf("state = %s", pending.State())
+			}
+			if tt.close && !errors.Is(pending.Err(), ErrClientClosed) {
+				t.Errorf("Err() = %v, want ErrClientClosed", pending.Err())
+			}
+		})
+	}
+}
+
+func TestWaitForDocumentInterval(t *testing.T) {
+	client, _ := newFakeClient(t)
+	if _, err := client.WaitForDocument(t.Context(), "receipt", "req1", 0); err == nil {
+		t.Error("expected a zero interval to be rejected")
+	}
+}
@@ -0,0 +1,337 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrNoRoute = errors.New("no printer routed for key")
+
+// RetryPolicy controls how often the pool repeats a failed call on the
+// same printer. Attempts is the total number of tries; 0 or 1 disables
+// retries. Only failures that are safe to repeat are retried: a send only
+// if it never reached the printer, confirms and status checks also after
+// a 5xx answer.
+type RetryPolicy struct {
+	Attempts int
+	Delay    time.Duration // Delay before the first retry, doubled for every next one
+}
+
+// Pool holds named clients, one per fiscal printer, and routes documents
+// to them by a store or register key.
+type Pool struct {
+	retry RetryPolicy
+
+	mu       sync.RWMutex
+	clients  map[string]*NovitusClient
+	routes   map[string]string // Route key -> client name
+	failover *FailoverPolicy
+	allowed  map[string][]string // Route key -> backup clients for fiscal documents
+	members  map[string]*memberState
+
+	batchConcurrency int
+}
+
+// PoolResult is the outcome of a document sent through the pool. Printer
+// is the name of the client that handled it; further calls for the
+// request, e.g. CheckDocumentStatus, have to go to that client.
+type PoolResult struct {
+	Printer string
+	Status  CheckDocumentStatusResponse
+}
+
+func NewPool(retry RetryPolicy) *Pool {
+	return &Pool{
+		retry:   retry,
+		clients: make(map[string]*NovitusClient),
+		routes:  make(map[string]string),
+		allowed: make(map[string][]string),
+		members: make(map[string]*memberState),
+	}
+}
+
+// Add creates a client for the printer at host and adds it under name.
+func (p *Pool) Add(name, host, token string, options ...ClientOption) (*NovitusClient, error) {
+	client, err := NewNovitusClient(host, token, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client %s: %w", name, err)
+	}
+	if err := p.AddClient(name, client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (p *Pool) AddClient(name string, client *NovitusClient) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.clients[name]; ok {
+		return fmt.Errorf("client %s already exists", name)
+	}
+	p.clients[name] = client
+	p.members[name] = &memberState{}
+	return nil
+}
+
+// Remove removes the client and every route pointing to it.
+func (p *Pool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, name)
+	delete(p.members, name)
+	for key, target := range p.routes {
+		if target == name {
+			delete(p.routes, key)
+		}
+	}
+	for key, backups := range p.allowed {
+		p.allowed[key] = slices.DeleteFunc(backups, func(backup string) bool { return backup == name })
+	}
+}
+
+func (p *Pool) Client(name string) (*NovitusClient, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	client, ok := p.clients[name]
+	return client, ok
+}
+
+// Names returns the names of all clients in sorted order.
+func (p *Pool) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	names := make([]string, 0, len(p.clients))
+	for name := range p.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Route sends documents for key, e.g. "store-1/register-2", to the client
+// name.
+func (p *Pool) Route(key, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.clients[name]; !ok {
+		return fmt.Errorf("unknown client %s", name)
+	}
+	p.routes[key] = name
+	return nil
+}
+
+// Resolve returns the client routed for key. A key without a route
+// resolves to the client of the same name.
+func (p *Pool) Resolve(key string) (string, *NovitusClient, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	name, ok := p.routes[key]
+	if !ok {
+		name = key
+	}
+	client, ok := p.clients[name]
+	if !ok {
+		return "", nil, fmt.Errorf("%w %s", ErrNoRoute, key)
+	}
+	return name, client, nil
+}
+
+// Send sends the document to the printer routed for key, optionally
+// confirms it and returns its status. Failed steps are retried on the
+// same printer according to the retry policy. With a failover policy set,
+// the document goes to a backup printer if the routed one is unhealthy or
+// the send failed before reaching it, see requestNotDelivered.
+func (p *Pool) Send(key, documentType string, document Document, confirm bool) (PoolResult, error) {
+	return p.SendContext(context.Background(), key, documentType, document, confirm)
+}
+
+// SendContext is Send with a context, which bounds the send and the
+// waits between retries.
+func (p *Pool) SendContext(ctx context.Context, key, documentType string, document Document, confirm bool) (PoolResult, error) {
+	if err := document.Validate(); err != nil {
+		return PoolResult{}, fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	candidates, err := p.candidates(key, documentType)
+	if err != nil {
+		return PoolResult{}, err
+	}
+	return p.sendCandidates(ctx, key, candidates, documentType, document, confirm)
+}
+
+// sendCandidates sends the validated document to the first of candidates
+// that takes it.
+func (p *Pool) sendCandidates(ctx context.Context, key string, candidates []string, documentType string, document Document, confirm bool) (PoolResult, error) {
+	var errs []error
+	for _, name := range candidates {
+		client, ok := p.Client(name)
+		if !ok {
+			continue
+		}
+		if err := p.checkQueue(client); err != nil {
+			errs = append(errs, fmt.Errorf("printer %s: %w", name, err))
+			continue
+		}
+		status, delivered, err := p.sendTo(ctx, client, documentType, document, confirm)
+		p.record(name, err)
+		if err == nil {
+			return PoolResult{Printer: name, Status: status}, nil
+		}
+		errs = append(errs, fmt.Errorf("printer %s: %w", name, err))
+		if delivered {
+			// The document may be stored on this printer; sending it to
+			// another one could print it twice.
+			return PoolResult{Printer: name, Status: status}, errors.Join(errs...)
+		}
+	}
+	if len(errs) == 0 {
+		return PoolResult{}, fmt.Errorf("%w: no healthy printer for key %s", ErrNoHealthyPrinter, key)
+	}
+	return PoolResult{}, errors.Join(errs...)
+}
+
+// sendTo reports whether the document may have reached the printer, even
+// if the send or a later step failed.
+func (p *Pool) sendTo(ctx context.Context, client *NovitusClient, documentType string, document Document, confirm bool) (CheckDocumentStatusResponse, bool, error) {
+	var sendDocumentResponse SendDocumentResponse
+	err := p.withRetry(ctx, requestNotDelivered, func() (err error) {
+		sendDocumentResponse, err = client.sendValidated(ctx, documentType, document)
+		return err
+	})
+	if err != nil {
+		return CheckDocumentStatusResponse{}, !requestNotDelivered(err), fmt.Errorf("failed to send document: %w", err)
+	}
+	requestId := sendDocumentResponse.Request.Id
+	if confirm {
+		err = p.withRetry(ctx, idempotentRetryable, func() error {
+			_, err := client.Confirm(documentType, requestId)
+			return err
+		})
+		if err != nil {
+			return CheckDocumentStatusResponse{Request: Request{Id: requestId}}, true, fmt.Errorf("failed to confirm document %s: %w", requestId, err)
+		}
+	}
+	var status CheckDocumentStatusResponse
+	err = p.withRetry(ctx, idempotentRetryable, func() (err error) {
+		status, err = client.CheckDocumentStatus(documentType, requestId)
+		return err
+	})
+	if err != nil {
+		return CheckDocumentStatusResponse{Request: Request{Id: requestId}}, true, err
+	}
+	return status, true, nil
+}
+
+// withRetry repeats call while it fails with an error retryable accepts
+// and attempts are left.
+func (p *Pool) withRetry(ctx context.Context, retryable func(error) bool, call func() error) error {
+	delay := p.retry.Delay
+	var err error
+	for attempt := 0; attempt < max(p.retry.Attempts, 1); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Join(err, ctx.Err())
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+		if err = call(); err == nil || !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// requestNotDelivered reports whether err proves the request never reached
+// the printer: the connection could not be opened, the circuit breaker is
+// open or the queue is full.
+func requestNotDelivered(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var queueFull *QueueFullError
+	return errors.Is(err, ErrCircuitOpen) || errors.As(err, &queueFull)
+}
+
+// idempotentRetryable reports whether a call that can be repeated without
+// side effects, e.g. a confirm or a status check, is worth retrying.
+func idempotentRetryable(err error) bool {
+	var statusErr *StatusError
+	return requestNotDelivered(err) || errors.As(err, &statusErr) && statusErr.StatusCode >= http.StatusInternalServerError
+}
+
+func (p *Pool) SendReceipt(key string, receipt *Receipt, confirm bool) (PoolResult, error) {
+	return p.Send(key, "receipt", receipt, confirm)
+}
+
+func (p *Pool) SendInvoice(key string, invoice *Invoice, confirm bool) (PoolResult, error) {
+	return p.Send(key, "invoice", invoice, confirm)
+}
+
+func (p *Pool) SendNFPrintout(key string, printout *Printout, confirm bool) (PoolResult, error) {
+	return p.Send(key, "nf_printout", printout, confirm)
+}
+
+// RefreshTokens refreshes the tokens of all clients that need it.
+func (p *Pool) RefreshTokens() error {
+	var mu sync.Mutex
+	var errs []error
+	p.each(func(name string, client *NovitusClient) {
+		if err := client.RefreshIfNeeded(); err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("client %s: %w", name, err))
+			mu.Unlock()
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// CheckHealth queries every printer for its device info and returns the
+// updated health of all clients.
+func (p *Pool) CheckHealth() map[string]DeviceHealth {
+	p.each(func(_ string, client *NovitusClient) {
+		client.GetDeviceInfo()
+	})
+	return p.Health()
+}
+
+// Health returns the cached health of all clients that have been checked.
+func (p *Pool) Health() map[string]DeviceHealth {
+	health := make(map[string]DeviceHealth)
+	for _, name := range p.Names() {
+		if client, ok := p.Client(name); ok {
+			if h, ok := client.Health(); ok {
+				health[name] = h
+			}
+		}
+	}
+	return health
+}
+
+// each calls f for every client concurrently and waits for all of them.
+func (p *Pool) each(f func(name string, client *NovitusClient)) {
+	p.mu.RLock()
+	clients := make(map[string]*NovitusClient, len(p.clients))
+	for name, client := range p.clients {
+		clients[name] = client
+	}
+	p.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for name, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f(name, client)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,188 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// closedHostClient returns a client with a valid token for a host that
+// refuses connections.
+func closedHostClient(t *testing.T) *NovitusClient {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	client, err := NewNovitusClient(srv.URL, "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setToken("t", time.Now().Add(time.Hour))
+	return client
+}
+
+func newTestPool(t *testing.T, retry RetryPolicy, names ...string) (*Pool, map[string]*fakeNovitus) {
+	pool := NewPool(retry)
+	fakes := make(map[string]*fakeNovitus)
+	for _, name := range names {
+		client, f := newFakeClient(t)
+		if err := pool.AddClient(name, client); err != nil {
+			t.Fatal(err)
+		}
+		fakes[name] = f
+	}
+	return pool, fakes
+}
+
+func TestPoolRoute(t *testing.T) {
+	pool, _ := newTestPool(t, RetryPolicy{}, "p1", "p2")
+	if err := pool.Route("store-1/register-1", "p2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.Route("store-1/register-2", "p3"); err == nil {
+		t.Error("expected an error for an unknown client")
+	}
+	if name, _, err := pool.Resolve("store-1/register-1"); err != nil || name != "p2" {
+		t.Errorf("Resolve() = %s, %v", name, err)
+	}
+	if name, _, err := pool.Resolve("p1"); err != nil || name != "p1" {
+		t.Errorf("Resolve() = %s, %v", name, err)
+	}
+	if _, _, err := pool.Resolve("nowhere"); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("Resolve() = %v", err)
+	}
+	pool.Remove("p2")
+	if _, _, err := pool.Resolve("store-1/register-1"); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("route to a removed client resolves: %v", err)
+	}
+	if names := pool.Names(); len(names) != 1 || names[0] != "p1" {
+		t.Errorf("Names() = %v", names)
+	}
+}
+
+func TestPoolSend(t *testing.T) {
+	pool, fakes := newTestPool(t, RetryPolicy{}, "p1")
+	result, err := pool.SendReceipt("p1", sampleReceipt(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Printer != "p1" || result.Status.Request.Id != "req1" {
+		t.Errorf("result = %+v", result)
+	}
+	if _, err := pool.SendReceipt("p1", &Receipt{}, true); err == nil {
+		t.Error("expected a validation error")
+	}
+	if fakes["p1"].count("POST /api/v1/receipt") != 1 {
+		t.Errorf("calls = %v", fakes["p1"].Calls())
+	}
+}
+
+func TestPoolRetry(t *testing.T) {
+	retry := RetryPolicy{Attempts: 3, Delay: time.Millisecond}
+	failWith := func(method string, n int32, fail func(w http.ResponseWriter)) func(w http.ResponseWriter, r *http.Request) bool {
+		var calls atomic.Int32
+		return func(w http.ResponseWriter, r *http.Request) bool {
+			if r.Method != method || calls.Add(1) > n {
+				return false
+			}
+			fail(w)
+			return true
+		}
+	}
+	serverError := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"exception":{"code":1,"description":"busy"}}`)
+	}
+	dropConnection := func(w http.ResponseWriter) {
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}
+	tests := []struct {
+		name    string
+		handle  func(w http.ResponseWriter, r *http.Request) bool
+		wantErr bool
+		calls   map[string]int
+	}{
+		{"send 5xx is not retried", failWith(http.MethodPost, 5, serverError), true,
+			map[string]int{"POST /api/v1/receipt": 1, "PUT /api/v1/receipt/req1": 0}},
+		{"dropped send is not retried", failWith(http.MethodPost, 5, dropConnection), true,
+			map[string]int{"POST /api/v1/receipt": 1}},
+		{"confirm 5xx is retried", failWith(http.MethodPut, 2, serverError), false,
+			map[string]int{"POST /api/v1/receipt": 1, "PUT /api/v1/receipt/req1": 3}},
+		{"confirm is retried up to the attempts", failWith(http.MethodPut, 5, serverError), true,
+			map[string]int{"POST /api/v1/receipt": 1, "PUT /api/v1/receipt/req1": 3}},
+		{"status 5xx is retried", failWith(http.MethodGet, 1, serverError), false,
+			map[string]int{"GET /api/v1/receipt/req1": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, fakes := newTestPool(t, retry, "p1")
+			fakes["p1"].setHandle(tt.handle)
+			result, err := pool.SendReceipt("p1", sampleReceipt(), true)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() = %+v, %v", result, err)
+			}
+			for call, want := range tt.calls {
+				if got := fakes["p1"].count(call); got != want {
+					t.Errorf("%s called %d times, want %d", call, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPoolRetryNotDelivered(t *testing.T) {
+	pool := NewPool(RetryPolicy{Attempts: 3, Delay: time.Hour})
+	pool.AddClient("p1", closedHostClient(t))
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := pool.SendContext(ctx, "p1", "receipt", sampleReceipt(), true)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the retry wait to stop with the context, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("retry did not stop with the context")
+	}
+}
+
+func TestRetryableErrors(t *testing.T) {
+	_, dialErr := closedHostClient(t).GetQueueStatus()
+	client, f := newFakeClient(t)
+	f.mu.Lock()
+	f.fail = http.StatusBadGateway
+	f.mu.Unlock()
+	_, serverErr := client.Confirm("receipt", "r1")
+	f.mu.Lock()
+	f.fail = http.StatusBadRequest
+	f.mu.Unlock()
+	_, badRequestErr := client.Confirm("receipt", "r1")
+
+	var statusErr *StatusError
+	if !errors.As(serverErr, &statusErr) || statusErr.StatusCode != http.StatusBadGateway || serverErr.Error() != "error confirming document: boom" {
+		t.Errorf("confirm error = %#v", serverErr)
+	}
+	tests := []struct {
+		name                   string
+		err                    error
+		notDelivered, retrying bool
+	}{
+		{"dial", dialErr, true, true},
+		{"circuit open", fmt.Errorf("failed: %w", ErrCircuitOpen), true, true},
+		{"queue full", &QueueFullError{RequestsInQueue: 5, MaxQueue: 5}, true, true},
+		{"5xx", serverErr, false, true},
+		{"4xx", badRequestErr, false, false},
+		{"timeout", context.DeadlineExceeded, false, false},
+	}
+	for _, tt := range tests {
+		if got := requestNotDelivered(tt.err); got != tt.notDelivered {
+			t.Errorf("%s: requestNotDelivered() = %v", tt.name, got)
+		}
+		if got := idempotentRetryable(tt.err); got != tt.retrying {
+			t.Errorf("%s: idempotentRetryable() = %v", tt.name, got)
+		}
+	}
+}
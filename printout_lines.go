@@ -0,0 +1,127 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// PrintoutLineItem is a single line of Printout.Lines or
+// Receipt/Invoice.PrintoutLines. LineType returns the key the line is
+// wrapped in on the wire, e.g. {"textline": {...}}.
+type PrintoutLineItem interface {
+	Validate() error
+	LineType() string
+}
+
+func (p *PrintoutLine) LineType() string { return "line" }
+func (tl *TextLine) LineType() string    { return "textline" }
+func (s *Separator) LineType() string    { return "separator" }
+func (i *Image) LineType() string        { return "image" }
+func (b *Barcode) LineType() string      { return "barcode" }
+func (q *QRCode) LineType() string       { return "qrcode" }
+
+func newPrintoutLineItem(lineType string) (PrintoutLineItem, error) {
+	switch lineType {
+	case "line":
+		return &PrintoutLine{}, nil
+	case "textline":
+		return &TextLine{}, nil
+	case "separator":
+		return &Separator{}, nil
+	case "image":
+		return &Image{}, nil
+	case "barcode":
+		return &Barcode{}, nil
+	case "qrcode":
+		return &QRCode{}, nil
+	}
+	return nil, fmt.Errorf("unsupported line type %q", lineType)
+}
+
+// PrintoutLines wraps every line in its type key when encoded to JSON and
+// unwraps it when decoded.
+type PrintoutLines []PrintoutLineItem
+
+// isNilLine reports whether line is nil or a nil pointer such as
+// (*TextLine)(nil).
+func isNilLine(line PrintoutLineItem) bool {
+	if line == nil {
+		return true
+	}
+	value := reflect.ValueOf(line)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+func (l PrintoutLines) Validate() error {
+	for idx, line := range l {
+		if isNilLine(line) {
+			return fmt.Errorf("line %d is nil", idx)
+		}
+		if err := line.Validate(); err != nil {
+			return fmt.Errorf("line %d (%s): %w", idx, line.LineType(), err)
+		}
+	}
+	return nil
+}
+
+func (l PrintoutLines) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("null"), nil
+	}
+	wrapped := make([]map[string]PrintoutLineItem, 0, len(l))
+	for idx, line := range l {
+		if isNilLine(line) {
+			return nil, fmt.Errorf("line %d is nil", idx)
+		}
+		wrapped = append(wrapped, map[string]PrintoutLineItem{line.LineType(): line})
+	}
+	return json.Marshal(wrapped)
+}
+
+// ParsePrintoutLines converts lines in the wire form used before
+// PrintoutLines existed, e.g. map[string]interface{}{"textline":
+// TextLine{...}}, or decoded from JSON.
+func ParsePrintoutLines(lines []interface{}) (PrintoutLines, error) {
+	if lines == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(lines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode printout lines: %w", err)
+	}
+	var parsed PrintoutLines
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode printout lines: %w", err)
+	}
+	return parsed, nil
+}
+
+func (l *PrintoutLines) UnmarshalJSON(data []byte) error {
+	var wrapped []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return err
+	}
+	if wrapped == nil {
+		*l = nil
+		return nil
+	}
+	lines := make(PrintoutLines, 0, len(wrapped))
+	for idx, entry := range wrapped {
+		if len(entry) != 1 {
+			return fmt.Errorf("line %d must have exactly one key, got %d", idx, len(entry))
+		}
+		for lineType, raw := range entry {
+			line, err := newPrintoutLineItem(lineType)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", idx, err)
+			}
+			if err := json.Unmarshal(raw, line); err != nil {
+				return fmt.Errorf("line %d (%s): %w", idx, lineType, err)
+			}
+			lines = append(lines, line)
+		}
+	}
+	*l = lines
+	return nil
+}
@@ -0,0 +1,108 @@
+package novitus_gosdk
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestPrintoutLinesJSON(t *testing.T) {
+	lines := PrintoutLines{
+		&TextLine{Text: "Pickup order", Bold: true},
+		&Separator{Char: "="},
+		&PrintoutLine{Text: "1234", Masked: true},
+	}
+	data, err := json.Marshal(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"textline":{"bold":true,"text":"Pickup order","masked":false}},{"separator":{"char":"="}},{"line":{"text":"1234","masked":true}}]`
+	if string(data) != want {
+		t.Errorf("got %s\nwant %s", data, want)
+	}
+	var decoded PrintoutLines
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 3 {
+		t.Fatalf("got %d lines", len(decoded))
+	}
+	if line, ok := decoded[0].(*TextLine); !ok || line.Text != "Pickup order" || !line.Bold {
+		t.Errorf("line 0 = %#v", decoded[0])
+	}
+	if _, ok := decoded[1].(*Separator); !ok {
+		t.Errorf("line 1 = %#v", decoded[1])
+	}
+}
+
+func TestPrintoutLinesUnmarshalErrors(t *testing.T) {
+	for _, data := range []string{
+		`[{"unknown":{}}]`,
+		`[{"textline":{},"line":{}}]`,
+		`[{"textline":{"text":1}}]`,
+	} {
+		var lines PrintoutLines
+		if err := json.Unmarshal([]byte(data), &lines); err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+	}
+}
+
+func TestParsePrintoutLines(t *testing.T) {
+	lines, err := ParsePrintoutLines([]interface{}{
+		map[string]interface{}{"textline": TextLine{Text: "old style", Center: true}},
+		map[string]interface{}{"line": map[string]interface{}{"text": "decoded", "masked": true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line, ok := lines[0].(*TextLine); !ok || line.Text != "old style" || !line.Center {
+		t.Errorf("line 0 = %#v", lines[0])
+	}
+	if line, ok := lines[1].(*PrintoutLine); !ok || !line.Masked {
+		t.Errorf("line 1 = %#v", lines[1])
+	}
+	if _, err := ParsePrintoutLines([]interface{}{"text"}); err == nil {
+		t.Error("expected an error for a line without a type key")
+	}
+}
+
+func TestPrintoutLinesValidate(t *testing.T) {
+	tests := []struct {
+		line PrintoutLineItem
+		err  string
+	}{
+		{&TextLine{Text: "a"}, ""},
+		{&TextLine{Text: "a", FontNumber: 3}, ""},
+		{&TextLine{Text: "a", FontNumber: 4}, "font_number must be between 1 and 3, or 0"},
+		{&TextLine{Text: "a", FontNumber: -1}, "font_number"},
+		{&TextLine{}, "text is required"},
+		{&TextLine{Text: "a", Height: -1}, "height"},
+		{&Separator{Char: "=="}, "single character"},
+		{&Image{}, "number must be a positive integer"},
+		{&PrintoutLine{}, "text is required"},
+	}
+	for _, tt := range tests {
+		err := PrintoutLines{tt.line}.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%#v: unexpected error %v", tt.line, err)
+		}
+		if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%#v: got %v, want %q", tt.line, err, tt.err)
+		}
+	}
+	for _, lines := range []PrintoutLines{{nil}, {(*TextLine)(nil)}, {&TextLine{Text: "a"}, (*QRCode)(nil)}} {
+		if err := lines.Validate(); err == nil || !strings.Contains(err.Error(), "is nil") {
+			t.Errorf("%#v: Validate() = %v, want a nil line error", lines, err)
+		}
+		if _, err := json.Marshal(lines); err == nil || !strings.Contains(err.Error(), "is nil") {
+			t.Errorf("%#v: Marshal() = %v, want a nil line error", lines, err)
+		}
+	}
+	if _, err := (TextRenderer{}).RenderPrintout(&Printout{Lines: PrintoutLines{(*TextLine)(nil)}}); err != nil {
+		t.Errorf("RenderPrintout() = %v", err)
+	}
+	if err := (&Printout{}).Validate(); err == nil {
+		t.Error("expected an error for a printout without lines")
+	}
+}
@@ -0,0 +1,103 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: PerSecond requests are allowed on average with
+// bursts of up to Burst requests.
+type Limit struct {
+	PerSecond float64
+	Burst     int // Defaults to 1
+}
+
+// RateLimit limits the requests sent to the Novitus host. Submit covers
+// documents, confirms, deletes and token calls, Poll covers status, queue,
+// device and JPK queries. A zero Limit leaves that kind of request
+// unlimited.
+type RateLimit struct {
+	Submit Limit
+	Poll   Limit
+}
+
+// WithRateLimit limits the requests of the client to the Novitus host.
+// The limit applies to this client only, so several clients of the same
+// host need lower limits each.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return func(n *NovitusClient) {
+		n.limiter = &rateLimiter{
+			submit: newTokenBucket(limit.Submit),
+			poll:   newTokenBucket(limit.Poll),
+		}
+	}
+}
+
+type rateLimiter struct {
+	submit *tokenBucket
+	poll   *tokenBucket
+}
+
+func (r *rateLimiter) wait(ctx context.Context, kind requestKind) error {
+	bucket := r.submit
+	if kind == requestPoll {
+		bucket = r.poll
+	}
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.wait(ctx); err != nil {
+		return fmt.Errorf("stopped waiting for rate limit: %w", err)
+	}
+	return nil
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit Limit) *tokenBucket {
+	if limit.PerSecond <= 0 {
+		return nil
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &tokenBucket{rate: limit.PerSecond, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait takes a token, waiting until one is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.reserve()
+		if delay == 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long to wait for the
+// next one.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,72 @@
+package novitus_gosdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	if newTokenBucket(Limit{}) != nil {
+		t.Error("a zero limit must not create a bucket")
+	}
+	bucket := newTokenBucket(Limit{PerSecond: 10, Burst: 3})
+	for i := range 3 {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("request %d of the burst waits %s", i, delay)
+		}
+	}
+	delay := bucket.reserve()
+	if delay <= 0 || delay > 100*time.Millisecond {
+		t.Errorf("request after the burst waits %s, want up to 100ms", delay)
+	}
+	bucket.last = bucket.last.Add(-time.Second)
+	for i := range 3 {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Errorf("request %d after refilling waits %s", i, delay)
+		}
+	}
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	bucket := newTokenBucket(Limit{PerSecond: 100})
+	start := time.Now()
+	for range 3 {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("3 requests at 100/s took %s", elapsed)
+	}
+
+	slow := newTokenBucket(Limit{PerSecond: 0.001})
+	slow.reserve()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := slow.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait() = %v", err)
+	}
+}
+
+func TestRateLimitKinds(t *testing.T) {
+	client, f := newFakeClient(t, WithRateLimit(RateLimit{Submit: Limit{PerSecond: 0.001, Burst: 2}}))
+	// The token call used the first submit token.
+	if _, err := client.SendDocument("receipt", sampleReceipt()); err != nil {
+		t.Fatal(err)
+	}
+	for range 5 {
+		if _, err := client.CheckDocumentStatus("receipt", "req1"); err != nil {
+			t.Fatalf("polls are not limited: %v", err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := client.SendDocumentContext(ctx, "receipt", sampleReceipt()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("send over the limit = %v", err)
+	}
+	if got := f.count("POST /api/v1/receipt"); got != 1 {
+		t.Errorf("sent %d documents, want 1", got)
+	}
+}
@@ -0,0 +1,347 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// TextRenderer lays documents out as monospaced text, approximating what
+// the printer will print. TextLine styles are rendered as markup tags:
+// [b]bold[/b], [big]big[/big] and [inv]inverted[/inv].
+type TextRenderer struct {
+	Width int      // Paper width in characters, e.g. 40, 48, 56. Defaults to 40, at least 20
+	Rates PTURates // PTU rates used in the tax summary. Defaults to DefaultPTURates
+}
+
+// minTextWidth is the narrowest paper the layout of amounts fits on.
+const minTextWidth = 20
+
+var paymentLabels = map[string]string{
+	"card":     "Karta",
+	"cheque":   "Czek",
+	"coupon":   "Bon",
+	"other":    "Inna",
+	"credit":   "Kredyt",
+	"account":  "Rachunek",
+	"transfer": "Przelew",
+	"mobile":   "Płatność mobilna",
+	"voucher":  "Voucher",
+}
+
+func (t TextRenderer) RenderReceipt(r *Receipt) (string, error) {
+	out, err := t.layout()
+	if err != nil {
+		return "", err
+	}
+	out.center("PARAGON FISKALNY")
+	out.separator('-')
+	if r.Buyer != nil && r.Buyer.Nip != "" {
+		out.text("NIP nabywcy: " + r.Buyer.Nip)
+		out.separator('-')
+	}
+	if err := t.renderSale(out, r.Items, r.Summary, r.Payments); err != nil {
+		return "", err
+	}
+	if len(r.PrintoutLines) > 0 {
+		out.separator('-')
+		t.renderLines(out, r.PrintoutLines)
+	}
+	if r.SystemInfo != nil {
+		t.renderSystemInfo(out, r.SystemInfo)
+	}
+	return out.String(), nil
+}
+
+func (t TextRenderer) RenderPrintout(p *Printout) (string, error) {
+	out, err := t.layout()
+	if err != nil {
+		return "", err
+	}
+	if p.Options == nil || !p.Options.WithoutHeader {
+		out.center("NIEFISKALNY")
+		out.separator('-')
+	}
+	t.renderLines(out, p.Lines)
+	if p.SystemInfo != nil {
+		t.renderSystemInfo(out, p.SystemInfo)
+	}
+	return out.String(), nil
+}
+
+func (t TextRenderer) layout() (*textLayout, error) {
+	width := t.Width
+	if width == 0 {
+		width = 40
+	}
+	if width < minTextWidth {
+		return nil, fmt.Errorf("width must be at least %d characters", minTextWidth)
+	}
+	return &textLayout{width: width}, nil
+}
+
+func (t TextRenderer) renderSale(out *textLayout, items []interface{}, summary Summary, payments []interface{}) error {
+	view := &documentView{}
+	if err := view.fillSale(items, summary, payments, t.Rates); err != nil {
+		return err
+	}
+	for _, item := range view.Items {
+		if item.Price == "" {
+			out.columns(item.Name, item.Value+ptuSuffix(item.PTU))
+			continue
+		}
+		out.text(item.Name)
+		out.columns("  "+item.Quantity+" x "+item.Price, item.Value+ptuSuffix(item.PTU))
+		if item.DiscountLabel != "" {
+			out.columns("  "+item.DiscountLabel, item.DiscountAmount+ptuSuffix(""))
+			out.columns("", item.Total+ptuSuffix(item.PTU))
+		}
+	}
+	out.separator('-')
+	if view.Discount != nil {
+		out.columns("Podsuma", view.Subtotal+ptuSuffix(""))
+		out.columns(view.Discount.Label, view.Discount.Amount+ptuSuffix(""))
+	}
+	for _, tax := range view.Taxes {
+		out.columns("Sprzed. opodatk. "+tax.PTU, tax.Gross)
+	}
+	for _, tax := range view.Taxes {
+		out.columns("Kwota "+tax.PTU+" "+tax.Rate, tax.VAT)
+	}
+	out.columns("Suma PTU", view.TaxTotal.VAT)
+	out.separator('-')
+	out.columns("[b]SUMA PLN[/b]", "[b]"+view.Total+"[/b]")
+	out.separator('-')
+	for _, payment := range view.Payments {
+		out.columns(payment.Label, payment.Amount)
+	}
+	if view.PayIn != "" {
+		out.columns("Wpłata", view.PayIn)
+	}
+	if view.Change != "" {
+		out.columns("Reszta", view.Change)
+	}
+	return nil
+}
+
+func (t TextRenderer) renderLines(out *textLayout, lines PrintoutLines) {
+	for _, line := range lines {
+		if isNilLine(line) {
+			continue
+		}
+		switch l := line.(type) {
+		case *TextLine:
+			text := l.Text
+			if l.Masked {
+				text = maskText(text)
+			}
+			// Big text is twice as wide, the indent is in normal columns.
+			scale := 1
+			if l.Big {
+				scale = 2
+			}
+			for _, part := range wrapText(text, out.width/scale) {
+				indent := ""
+				if l.Center {
+					indent = spaces((out.width - scale*runeLen(part)) / 2)
+				}
+				out.raw(indent + styleText(part, l))
+			}
+		case *PrintoutLine:
+			text := l.Text
+			if l.Masked {
+				text = maskText(text)
+			}
+			out.text(text)
+		case *Separator:
+			char := '-'
+			if l.Char != "" {
+				char = []rune(l.Char)[0]
+			}
+			out.separator(char)
+		case *Image:
+			out.center(fmt.Sprintf("[grafika %d]", l.Number))
+		case *Barcode:
+			if l.HRI == HRIAbove || l.HRI == HRIBoth {
+				out.center(l.Code)
+			}
+			out.center("||| " + strings.ToUpper(string(l.Type)) + " |||")
+			if l.HRI == HRIBelow || l.HRI == HRIBoth {
+				out.center(l.Code)
+			}
+		case *QRCode:
+			out.center("[QR]")
+			if l.HRI {
+				for _, part := range wrapText(l.Code, out.width) {
+					out.center(part)
+				}
+			}
+		}
+	}
+}
+
+func (t TextRenderer) renderSystemInfo(out *textLayout, info *SystemInfo) {
+	out.separator('-')
+	if info.CashNumer != "" {
+		out.text("Kasa: " + info.CashNumer)
+	}
+	if info.CashierName != "" {
+		out.text("Kasjer: " + info.CashierName)
+	}
+	if info.SystemNumber != "" {
+		out.text("Nr sys.: " + info.SystemNumber)
+	}
+}
+
+// ptuSuffix keeps amounts without a PTU letter aligned with item values.
+func ptuSuffix(ptu string) string {
+	if ptu == "" {
+		return "  "
+	}
+	return " " + ptu
+}
+
+func discountMarkupLabel(d *DiscountMarkup) string {
+	label := "Rabat"
+	if !d.Type.IsDiscount() {
+		label = "Narzut"
+	}
+	if d.Name != "" {
+		label += " " + d.Name
+	}
+	if d.Type.IsPercent() {
+		label += " " + formatValueString(d.Value) + "%"
+	}
+	return label
+}
+
+func styleText(text string, l *TextLine) string {
+	if l.Bold {
+		text = "[b]" + text + "[/b]"
+	}
+	if l.Big {
+		text = "[big]" + text + "[/big]"
+	}
+	if l.Invers {
+		text = "[inv]" + text + "[/inv]"
+	}
+	return text
+}
+
+// maskText hides all but the last four characters, the way the printer
+// masks card numbers.
+func maskText(text string) string {
+	runes := []rune(text)
+	for i := 0; i < len(runes)-4; i++ {
+		if runes[i] != ' ' {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+func formatAmount(d decimal.Decimal) string {
+	return strings.Replace(d.StringFixed(2), ".", ",", 1)
+}
+
+func formatValueString(value string) string {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return value
+	}
+	return formatAmount(d)
+}
+
+func formatQuantity(quantity string) string {
+	d, err := decimal.NewFromString(quantity)
+	if err != nil {
+		return quantity
+	}
+	return strings.Replace(d.String(), ".", ",", 1)
+}
+
+type textLayout struct {
+	width int
+	b     strings.Builder
+}
+
+func (l *textLayout) String() string {
+	return l.b.String()
+}
+
+func (l *textLayout) raw(line string) {
+	l.b.WriteString(strings.TrimRight(line, " "))
+	l.b.WriteByte('\n')
+}
+
+func (l *textLayout) text(text string) {
+	for _, part := range wrapText(text, l.width) {
+		l.raw(part)
+	}
+}
+
+func (l *textLayout) center(text string) {
+	for _, part := range wrapText(text, l.width) {
+		l.raw(spaces((l.width-runeLen(part))/2) + part)
+	}
+}
+
+func (l *textLayout) separator(char rune) {
+	l.raw(strings.Repeat(string(char), l.width))
+}
+
+// columns prints left aligned to the left edge and right aligned to the
+// right edge. If both do not fit on one line, right goes to the next one,
+// wrapped if it is wider than the line.
+func (l *textLayout) columns(left, right string) {
+	leftLen, rightLen := markupLen(left), markupLen(right)
+	if leftLen+rightLen+1 > l.width {
+		l.text(left)
+		left, leftLen = "", 0
+	}
+	if rightLen > l.width {
+		l.text(right)
+		return
+	}
+	l.raw(left + spaces(l.width-leftLen-rightLen) + right)
+}
+
+// spaces returns n spaces, none if n is negative.
+func spaces(n int) string {
+	return strings.Repeat(" ", max(n, 0))
+}
+
+func wrapText(text string, width int) []string {
+	runes := []rune(text)
+	if width <= 0 || len(runes) <= width {
+		return []string{text}
+	}
+	var parts []string
+	for len(runes) > width {
+		cut := width
+		for i := width; i > 0; i-- {
+			if runes[i] == ' ' {
+				cut = i
+				break
+			}
+		}
+		parts = append(parts, strings.TrimRight(string(runes[:cut]), " "))
+		runes = []rune(strings.TrimLeft(string(runes[cut:]), " "))
+	}
+	if len(runes) > 0 {
+		parts = append(parts, string(runes))
+	}
+	return parts
+}
+
+func runeLen(text string) int {
+	return len([]rune(text))
+}
+
+var markupTags = strings.NewReplacer("[b]", "", "[/b]", "", "[big]", "", "[/big]", "", "[inv]", "", "[/inv]", "")
+
+// markupLen is the printed width of text, not counting markup tags.
+func markupLen(text string) int {
+	return runeLen(markupTags.Replace(text))
+}
@@ -0,0 +1,97 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// HTMLRenderer renders electronic copies of documents as standalone HTML
+// pages, e.g. for e-receipt delivery.
+type HTMLRenderer struct {
+	Seller *Seller  // Seller block. For invoices Invoice.Seller is used if empty
+	Rates  PTURates // PTU rates used in the tax summary. Defaults to DefaultPTURates
+}
+
+// RenderReceipt writes the receipt as HTML. requestId is the Novitus
+// request id of the printed document and may be empty.
+func (h HTMLRenderer) RenderReceipt(w io.Writer, r *Receipt, requestId string) error {
+	view, err := newReceiptView(r, h.Seller, h.Rates, requestId)
+	if err != nil {
+		return fmt.Errorf("failed to prepare receipt: %w", err)
+	}
+	return htmlTemplate.Execute(w, view)
+}
+
+// RenderInvoice writes the invoice as HTML. requestId is the Novitus
+// request id of the printed document and may be empty.
+func (h HTMLRenderer) RenderInvoice(w io.Writer, i *Invoice, requestId string) error {
+	view, err := newInvoiceView(i, h.Seller, h.Rates, requestId)
+	if err != nil {
+		return fmt.Errorf("failed to prepare invoice: %w", err)
+	}
+	return htmlTemplate.Execute(w, view)
+}
+
+var htmlTemplate = template.Must(template.New("document").Parse(`<!DOCTYPE html>
+<html lang="pl">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}{{if .Number}} {{.Number}}{{end}}</title>
+<style>
+body { font-family: "Helvetica Neue", Arial, sans-serif; color: #222; max-width: 720px; margin: 2em auto; padding: 0 1em; }
+h1 { font-size: 1.4em; border-bottom: 2px solid #222; padding-bottom: .3em; }
+.parties { display: flex; gap: 2em; margin-bottom: 1.5em; }
+.party { flex: 1; }
+.party h2 { font-size: .9em; text-transform: uppercase; color: #666; margin: 0 0 .3em; }
+.party p { margin: 0; }
+table { width: 100%; border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { padding: .35em .5em; border-bottom: 1px solid #ddd; text-align: left; }
+th { background: #f4f4f4; font-size: .85em; }
+td.num, th.num { text-align: right; white-space: nowrap; }
+.discount td { color: #666; font-size: .9em; border-bottom: none; }
+.total td { font-weight: bold; font-size: 1.2em; border-bottom: 2px solid #222; }
+.notes p { margin: .2em 0; }
+footer { color: #666; font-size: .8em; margin-top: 2em; border-top: 1px solid #ddd; padding-top: .5em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}{{if .Number}} nr {{.Number}}{{end}}</h1>
+{{if or .DateOfSell .PaymentForm}}<p>{{if .DateOfSell}}Data sprzedaży: {{.DateOfSell}}{{end}}{{if and .DateOfSell .PaymentForm}}<br>{{end}}{{if .PaymentForm}}Forma płatności: {{.PaymentForm}}{{end}}</p>{{end}}
+{{if or .Seller .Buyer}}<div class="parties">
+{{with .Seller}}<div class="party"><h2>Sprzedawca</h2><p>{{.Name}}</p>{{range .Address}}<p>{{.}}</p>{{end}}{{if .Nip}}<p>NIP: {{.Nip}}</p>{{end}}</div>{{end}}
+{{with .Buyer}}<div class="party"><h2>Nabywca</h2>{{if .Name}}<p>{{.Name}}</p>{{end}}{{range .Address}}<p>{{.}}</p>{{end}}{{if .Nip}}<p>NIP: {{.Nip}}</p>{{end}}{{if .Id}}<p>{{if .IdType}}{{.IdType}}: {{end}}{{.Id}}</p>{{end}}</div>{{end}}
+{{if .Recipient}}<div class="party"><h2>Odbiorca</h2><p>{{.Recipient}}</p></div>{{end}}
+</div>{{end}}
+<table class="items">
+<thead><tr><th>Nazwa</th><th class="num">Ilość</th><th class="num">Cena</th><th class="num">Wartość</th><th>PTU</th></tr></thead>
+<tbody>
+{{range .Items}}<tr><td>{{.Name}}</td><td class="num">{{.Quantity}}</td><td class="num">{{.Price}}</td><td class="num">{{.Value}}</td><td>{{.PTU}}</td></tr>
+{{if .DiscountLabel}}<tr class="discount"><td colspan="3">{{.DiscountLabel}}</td><td class="num">{{.DiscountAmount}}</td><td></td></tr>
+<tr class="discount"><td colspan="3"></td><td class="num">{{.Total}}</td><td>{{.PTU}}</td></tr>
+{{end}}{{end}}</tbody>
+</table>
+{{with .Discount}}<table class="summary-discount">
+<tr><td>Podsuma</td><td class="num">{{$.Subtotal}}</td></tr>
+<tr><td>{{.Label}}</td><td class="num">{{.Amount}}</td></tr>
+</table>{{end}}
+<table class="taxes">
+<thead><tr><th>PTU</th><th class="num">Stawka</th><th class="num">Netto</th><th class="num">VAT</th><th class="num">Brutto</th></tr></thead>
+<tbody>
+{{range .Taxes}}<tr><td>{{.PTU}}</td><td class="num">{{.Rate}}</td><td class="num">{{.Net}}</td><td class="num">{{.VAT}}</td><td class="num">{{.Gross}}</td></tr>
+{{end}}<tr><td colspan="2">Razem</td><td class="num">{{.TaxTotal.Net}}</td><td class="num">{{.TaxTotal.VAT}}</td><td class="num">{{.TaxTotal.Gross}}</td></tr>
+</tbody>
+</table>
+<table class="payments">
+<tr class="total"><td>Suma PLN</td><td class="num">{{.Total}}</td></tr>
+{{range .Payments}}<tr><td>{{.Label}}</td><td class="num">{{.Amount}}</td></tr>
+{{end}}{{if .PayIn}}<tr><td>Wpłata</td><td class="num">{{.PayIn}}</td></tr>
+{{end}}{{if .Change}}<tr><td>Reszta</td><td class="num">{{.Change}}</td></tr>
+{{end}}</table>
+{{if .Notes}}<div class="notes">{{range .Notes}}<p>{{.}}</p>{{end}}</div>{{end}}
+<footer>
+{{if .CashNumber}}Kasa: {{.CashNumber}}<br>{{end}}{{if .Cashier}}Kasjer: {{.Cashier}}<br>{{end}}{{if .RequestId}}Identyfikator żądania Novitus: {{.RequestId}}<br>{{end}}Kopia elektroniczna dokumentu
+</footer>
+</body>
+</html>
+`))
@@ -0,0 +1,92 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func sampleInvoice() *Invoice {
+	return &Invoice{
+		Info:      Info{Number: "FV 1/2026", DateOfSell: "2026-10-19", PaymentForm: "przelew"},
+		Buyer:     Buyer{Name: "Nabywca <sp. z o.o.>", Nip: "5260250274", Address: []string{"ul. Prosta 1"}},
+		Recipient: &TransactionSide{Name: "Odbiorca"},
+		Items: []interface{}{
+			map[string]interface{}{"article": Article{Name: "Usługa", PTU: "A", Quantity: "2", Price: "61.50", Value: "123.00"}},
+			article("B", "10.80"),
+		},
+		Summary:        Summary{Total: "133.80"},
+		Payments:       []interface{}{map[string]interface{}{"transfer": TypicalPaymentMethod{Value: "133.80"}}},
+		AdditionalInfo: []AdditionalInfo{{Text: "Termin płatności 7 dni"}},
+		SystemInfo:     SystemInfo{CashNumer: "K1", CashierName: "Anna"},
+	}
+}
+
+func TestHTMLRenderInvoice(t *testing.T) {
+	var out bytes.Buffer
+	if err := (HTMLRenderer{}).RenderInvoice(&out, sampleInvoice(), "req-1"); err != nil {
+		t.Fatal(err)
+	}
+	html := out.String()
+	for _, want := range []string{
+		"<h1>Faktura VAT nr FV 1/2026</h1>",
+		"Forma płatności: przelew",
+		"Nabywca &lt;sp. z o.o.&gt;",
+		"<p>NIP: 5260250274</p>",
+		"<h2>Odbiorca</h2><p>Odbiorca</p>",
+		`<td>Usługa</td><td class="num">2</td><td class="num">61,50</td><td class="num">123,00</td><td>A</td>`,
+		`<td>A</td><td class="num">23,00%</td><td class="num">100,00</td><td class="num">23,00</td><td class="num">123,00</td>`,
+		`<td>B</td><td class="num">8,00%</td><td class="num">10,00</td><td class="num">0,80</td><td class="num">10,80</td>`,
+		`<td colspan="2">Razem</td><td class="num">110,00</td><td class="num">23,80</td><td class="num">133,80</td>`,
+		`<tr><td>Przelew</td><td class="num">133,80</td></tr>`,
+		"<p>Termin płatności 7 dni</p>",
+		"Kasjer: Anna",
+		"Identyfikator żądania Novitus: req-1",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("missing %q in\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "<sp. z o.o.>") {
+		t.Error("buyer name is not escaped")
+	}
+}
+
+func TestHTMLRenderReceipt(t *testing.T) {
+	receipt := &Receipt{
+		Items: []interface{}{
+			map[string]interface{}{"article": Article{Name: "Pizza", PTU: "A", Quantity: "1", Price: "20.00", Value: "20.00"}},
+		},
+		Summary: Summary{Total: "18.00", DiscountMarkup: &DiscountMarkup{Type: PercentDiscount, Value: "10"}},
+		PrintoutLines: PrintoutLines{
+			&PrintoutLine{Text: "4111 1111 1111 1111", Masked: true},
+		},
+	}
+	var out bytes.Buffer
+	if err := (HTMLRenderer{Seller: &Seller{Name: "Pizzeria"}}).RenderReceipt(&out, receipt, ""); err != nil {
+		t.Fatal(err)
+	}
+	html := out.String()
+	for _, want := range []string{
+		"<h1>Paragon fiskalny</h1>",
+		"<h2>Sprzedawca</h2><p>Pizzeria</p>",
+		`<tr><td>Podsuma</td><td class="num">20,00</td></tr>`,
+		`<tr><td>Rabat 10,00%</td><td class="num">-2,00</td></tr>`,
+		`<tr class="total"><td>Suma PLN</td><td class="num">18,00</td></tr>`,
+		"<p>**** **** **** 1111</p>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("missing %q in\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "Identyfikator") {
+		t.Error("empty request id is rendered")
+	}
+}
+
+func TestHTMLRenderInvalid(t *testing.T) {
+	receipt := &Receipt{Items: []interface{}{article("X", "1.00")}, Summary: Summary{Total: "1.00"}}
+	if err := (HTMLRenderer{}).RenderReceipt(&bytes.Buffer{}, receipt, ""); err == nil {
+		t.Error("expected an error for an unknown PTU rate")
+	}
+}
@@ -0,0 +1,236 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PDFRenderer renders electronic copies of documents as A4 PDF files. The
+// PDF is generated in pure Go using the Courier base font, so no external
+// tools or embedded fonts are needed.
+type PDFRenderer struct {
+	Seller *Seller  // Seller block. For invoices Invoice.Seller is used if empty
+	Rates  PTURates // PTU rates used in the tax summary. Defaults to DefaultPTURates
+}
+
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 9
+	pdfLineHeight   = 11
+	pdfLineWidth    = 90 // Courier is 0.6em wide: 90 * 5.4pt fits 515pt between margins
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+// RenderReceipt writes the receipt as PDF. requestId is the Novitus
+// request id of the printed document and may be empty.
+func (p PDFRenderer) RenderReceipt(w io.Writer, r *Receipt, requestId string) error {
+	view, err := newReceiptView(r, p.Seller, p.Rates, requestId)
+	if err != nil {
+		return fmt.Errorf("failed to prepare receipt: %w", err)
+	}
+	return writePDF(w, layoutDocumentView(view, pdfLineWidth))
+}
+
+// RenderInvoice writes the invoice as PDF. requestId is the Novitus
+// request id of the printed document and may be empty.
+func (p PDFRenderer) RenderInvoice(w io.Writer, i *Invoice, requestId string) error {
+	view, err := newInvoiceView(i, p.Seller, p.Rates, requestId)
+	if err != nil {
+		return fmt.Errorf("failed to prepare invoice: %w", err)
+	}
+	return writePDF(w, layoutDocumentView(view, pdfLineWidth))
+}
+
+// layoutDocumentView lays the document out as text lines; lines wrapped in
+// [b] markup are printed in bold.
+func layoutDocumentView(v *documentView, width int) []string {
+	out := &textLayout{width: width}
+	title := v.Title
+	if v.Number != "" {
+		title += " nr " + v.Number
+	}
+	out.raw("[b]" + title + "[/b]")
+	out.separator('=')
+	if v.DateOfSell != "" {
+		out.text("Data sprzedaży: " + v.DateOfSell)
+	}
+	if v.PaymentForm != "" {
+		out.text("Forma płatności: " + v.PaymentForm)
+	}
+	if v.Seller != nil {
+		out.raw("")
+		out.raw("[b]Sprzedawca[/b]")
+		out.text(v.Seller.Name)
+		for _, line := range v.Seller.Address {
+			out.text(line)
+		}
+		if v.Seller.Nip != "" {
+			out.text("NIP: " + v.Seller.Nip)
+		}
+	}
+	if v.Buyer != nil {
+		out.raw("")
+		out.raw("[b]Nabywca[/b]")
+		if v.Buyer.Name != "" {
+			out.text(v.Buyer.Name)
+		}
+		for _, line := range v.Buyer.Address {
+			out.text(line)
+		}
+		if v.Buyer.Nip != "" {
+			out.text("NIP: " + v.Buyer.Nip)
+		}
+		if v.Buyer.Id != "" {
+			out.text(strings.TrimPrefix(string(v.Buyer.IdType)+": ", ": ") + v.Buyer.Id)
+		}
+	}
+	if v.Recipient != "" {
+		out.raw("")
+		out.raw("[b]Odbiorca[/b]")
+		out.text(v.Recipient)
+	}
+	out.raw("")
+	out.columns("[b]Nazwa[/b]", "[b]"+fmt.Sprintf("%10s %10s %3s %11s", "Ilość", "Cena", "PTU", "Wartość")+"[/b]")
+	out.separator('-')
+	for _, item := range v.Items {
+		out.columns(item.Name, fmt.Sprintf("%10s %10s %3s %11s", item.Quantity, item.Price, item.PTU, item.Value))
+		if item.DiscountLabel != "" {
+			out.columns("  "+item.DiscountLabel, item.DiscountAmount)
+			out.columns("", fmt.Sprintf("%3s %11s", item.PTU, item.Total))
+		}
+	}
+	out.separator('-')
+	if v.Discount != nil {
+		out.columns("Podsuma", v.Subtotal)
+		out.columns(v.Discount.Label, v.Discount.Amount)
+		out.separator('-')
+	}
+	out.columns("[b]PTU[/b]", "[b]"+fmt.Sprintf("%10s %11s %11s %11s", "Stawka", "Netto", "VAT", "Brutto")+"[/b]")
+	for _, tax := range v.Taxes {
+		out.columns(tax.PTU, fmt.Sprintf("%10s %11s %11s %11s", tax.Rate, tax.Net, tax.VAT, tax.Gross))
+	}
+	out.columns("Razem", fmt.Sprintf("%11s %11s %11s", v.TaxTotal.Net, v.TaxTotal.VAT, v.TaxTotal.Gross))
+	out.separator('-')
+	out.columns("[b]SUMA PLN[/b]", "[b]"+v.Total+"[/b]")
+	for _, payment := range v.Payments {
+		out.columns(payment.Label, payment.Amount)
+	}
+	if v.PayIn != "" {
+		out.columns("Wpłata", v.PayIn)
+	}
+	if v.Change != "" {
+		out.columns("Reszta", v.Change)
+	}
+	if len(v.Notes) > 0 {
+		out.raw("")
+		for _, note := range v.Notes {
+			out.text(note)
+		}
+	}
+	out.raw("")
+	out.separator('-')
+	if v.CashNumber != "" {
+		out.text("Kasa: " + v.CashNumber)
+	}
+	if v.Cashier != "" {
+		out.text("Kasjer: " + v.Cashier)
+	}
+	if v.RequestId != "" {
+		out.text("Identyfikator żądania Novitus: " + v.RequestId)
+	}
+	out.text("Kopia elektroniczna dokumentu")
+	return strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+}
+
+// pdfEncoding maps Polish letters missing from WinAnsiEncoding to the
+// codes redefined in the font /Differences array.
+var pdfEncoding = map[rune]byte{
+	'ą': 128, 'ć': 129, 'ę': 130, 'ł': 131, 'ń': 132, 'ś': 133, 'ź': 134, 'ż': 135,
+	'Ą': 136, 'Ć': 137, 'Ę': 138, 'Ł': 139, 'Ń': 140, 'Ś': 141, 'Ź': 142, 'Ż': 143,
+}
+
+const pdfDifferences = "[128 /aogonek /cacute /eogonek /lslash /nacute /sacute /zacute /zdotaccent " +
+	"/Aogonek /Cacute /Eogonek /Lslash /Nacute /Sacute /Zacute /Zdotaccent]"
+
+// pdfString encodes text as a PDF literal string in the font encoding.
+func pdfString(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range text {
+		if code, ok := pdfEncoding[r]; ok {
+			fmt.Fprintf(&b, "\\%03o", code)
+			continue
+		}
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r < 127:
+			b.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func writePDF(w io.Writer, lines []string) error {
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// Objects: 1 catalog, 2 page tree, 3 regular font, 4 bold font, then a
+	// page and its content stream for every page.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding << /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences " + pdfDifferences + " >> >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding << /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences " + pdfDifferences + " >> >>",
+	}
+	var kids []string
+	for _, page := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n%d TL\n%d %d Td\n", pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+		for _, line := range page {
+			font := "/F1"
+			if strings.Contains(line, "[b]") {
+				font = "/F2"
+			}
+			fmt.Fprintf(&content, "%s %d Tf\n%s Tj\nT*\n", font, pdfFontSize, pdfString(markupTags.Replace(line)))
+		}
+		content.WriteString("ET")
+		pageId := len(objects) + 1
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, pageId+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageId))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for idx, object := range objects {
+		offsets[idx] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", idx+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	_, err := w.Write(out.Bytes())
+	return err
+}
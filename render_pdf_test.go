@@ -0,0 +1,91 @@
+package novitus_gosdk
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPDFString(t *testing.T) {
+	tests := []struct {
+		text, want string
+	}{
+		{"Suma", "(Suma)"},
+		{"a(b)c\\", `(a\(b\)c\\)`},
+		{"Zażółć", `(Za\207\363\203\201)`},
+		{"€", "(?)"},
+	}
+	for _, tt := range tests {
+		if got := pdfString(tt.text); got != tt.want {
+			t.Errorf("pdfString(%q) = %s, want %s", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestPDFRenderInvoice(t *testing.T) {
+	var out bytes.Buffer
+	if err := (PDFRenderer{}).RenderInvoice(&out, sampleInvoice(), "req-1"); err != nil {
+		t.Fatal(err)
+	}
+	pdf := out.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatal("not a PDF file")
+	}
+	for _, want := range []string{"/Count 1", "/F2 9 Tf\n(Faktura VAT nr FV 1/2026) Tj", "(Identyfikator \\207\\200dania Novitus: req-1) Tj"} {
+		if !strings.Contains(pdf, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	checkPDFXref(t, pdf)
+}
+
+func TestPDFPages(t *testing.T) {
+	receipt := &Receipt{Summary: Summary{Total: "100.00"}}
+	for i := 0; i < 100; i++ {
+		receipt.Items = append(receipt.Items, article("A", "1.00"))
+	}
+	var out bytes.Buffer
+	if err := (PDFRenderer{}).RenderReceipt(&out, receipt, ""); err != nil {
+		t.Fatal(err)
+	}
+	pdf := out.String()
+	if !strings.Contains(pdf, "/Count 2") {
+		t.Error("expected the receipt to span two pages")
+	}
+	checkPDFXref(t, pdf)
+}
+
+func TestLayoutDocumentViewWidth(t *testing.T) {
+	view, err := newInvoiceView(sampleInvoice(), nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range layoutDocumentView(view, pdfLineWidth) {
+		if markupLen(line) > pdfLineWidth {
+			t.Errorf("line %q is wider than %d characters", line, pdfLineWidth)
+		}
+	}
+}
+
+// checkPDFXref checks that every xref entry points at its object.
+func checkPDFXref(t *testing.T, pdf string) {
+	t.Helper()
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	if match == nil {
+		t.Fatal("missing startxref")
+	}
+	start, _ := strconv.Atoi(match[1])
+	if !strings.HasPrefix(pdf[start:], "xref\n") {
+		t.Fatal("startxref does not point at the xref table")
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[start:], -1)
+	for idx, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		if want := fmt.Sprintf("%d 0 obj\n", idx+1); !strings.HasPrefix(pdf[offset:], want) {
+			t.Errorf("xref entry %d points at %q", idx+1, pdf[offset:offset+10])
+		}
+	}
+}
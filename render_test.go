@@ -0,0 +1,121 @@
+package novitus_gosdk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderReceipt(t *testing.T) {
+	receipt := &Receipt{
+		Items: []interface{}{
+			map[string]interface{}{"article": Article{Name: "Pizza", PTU: "A", Quantity: "2", Price: "5.00", Value: "10.00",
+				DiscountMarkup: &DiscountMarkup{Type: PercentDiscount, Value: "10"}}},
+			article("E", "3.00"),
+		},
+		Summary:  Summary{Total: "12.00", PayIn: "20.00", Change: "8.00"},
+		Payments: []interface{}{map[string]interface{}{"cash": Cash{Value: "20.00"}}},
+		PrintoutLines: PrintoutLines{
+			&TextLine{Text: "Thanks", Center: true, Bold: true},
+			&PrintoutLine{Text: "4111 1111 1111 1111", Masked: true},
+		},
+	}
+	got, err := TextRenderer{Width: 32}.RenderReceipt(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `        PARAGON FISKALNY
+--------------------------------
+Pizza
+  2 x 5,00               10,00 A
+  Rabat 10,00%           -1,00
+                          9,00 A
+item
+  1 x 3,00                3,00 E
+--------------------------------
+Sprzed. opodatk. A          9,00
+Sprzed. opodatk. E          3,00
+Kwota A 23,00%              1,68
+Kwota E 0,00%               0,00
+Suma PTU                    1,68
+--------------------------------
+[b]SUMA PLN[/b]                   [b]12,00[/b]
+--------------------------------
+Gotówka                    20,00
+Wpłata                     20,00
+Reszta                      8,00
+--------------------------------
+             [b]Thanks[/b]
+**** **** **** 1111
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderWidth(t *testing.T) {
+	printout := &Printout{Lines: PrintoutLines{&TextLine{Text: "a", Center: true}}}
+	for _, width := range []int{-1, 8, 19} {
+		if _, err := (TextRenderer{Width: width}).RenderPrintout(printout); err == nil {
+			t.Errorf("width %d: expected an error", width)
+		}
+	}
+	got, err := TextRenderer{}.RenderPrintout(printout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(got, "\n"); len(lines[1]) != 40 {
+		t.Errorf("default width: separator is %d characters long", len(lines[1]))
+	}
+}
+
+func TestTextLayoutNarrow(t *testing.T) {
+	out := &textLayout{width: 8}
+	out.columns("[b]SUMA PLN[/b]", "[b]123456,78[/b]")
+	out.center("wider than the line")
+	for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+		if markupLen(line) > 8 {
+			t.Errorf("line %q is wider than 8 characters", line)
+		}
+	}
+
+	big := &textLayout{width: 20}
+	TextRenderer{}.renderLines(big, PrintoutLines{&TextLine{Text: "a very long centered big line", Big: true, Center: true}})
+	if !strings.Contains(big.String(), "[big]") {
+		t.Errorf("got %q", big.String())
+	}
+}
+
+func TestTextLineCenter(t *testing.T) {
+	tests := []struct {
+		line *TextLine
+		want string
+	}{
+		{&TextLine{Text: "HELLO", Center: true}, strings.Repeat(" ", 17) + "HELLO"},
+		{&TextLine{Text: "HELLO", Center: true, Big: true}, strings.Repeat(" ", 15) + "[big]HELLO[/big]"},
+	}
+	for _, tt := range tests {
+		out := &textLayout{width: 40}
+		TextRenderer{}.renderLines(out, PrintoutLines{tt.line})
+		if got := strings.TrimSuffix(out.String(), "\n"); got != tt.want {
+			t.Errorf("Big %v: got %q, want %q", tt.line.Big, got, tt.want)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"short", 10, []string{"short"}},
+		{"two words here", 9, []string{"two words", "here"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+	}
+	for _, tt := range tests {
+		got := wrapText(tt.text, tt.width)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("wrapText(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
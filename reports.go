@@ -0,0 +1,69 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"time"
+)
+
+const reportDateLayout = "2006-01-02"
+
+type DailyReport struct {
+	Date       string      `json:"date,omitempty"` // Fiscal day to close, e.g. "2025-01-31". Current fiscal day if empty
+	SystemInfo *SystemInfo `json:"system_info,omitempty"`
+}
+
+func (d *DailyReport) Validate() error {
+	if d.Date != "" {
+		if _, err := time.Parse(reportDateLayout, d.Date); err != nil {
+			return fmt.Errorf("date must be in format YYYY-MM-DD")
+		}
+	}
+	return nil
+}
+
+type PeriodicReportType string
+
+const (
+	PeriodicReportFull    PeriodicReportType = "full"    // Full report with every daily report of the period
+	PeriodicReportSummary PeriodicReportType = "summary" // Totals of the period only
+)
+
+type PeriodicReport struct {
+	Type       PeriodicReportType `json:"type"`      // Enum: "full" "summary" Required: true
+	DateFrom   string             `json:"date_from"` // e.g. "2025-01-01" Required: true
+	DateTo     string             `json:"date_to"`   // e.g. "2025-01-31" Required: true
+	Monthly    bool               `json:"monthly,omitempty"`
+	SystemInfo *SystemInfo        `json:"system_info,omitempty"`
+}
+
+// NewMonthlyReport returns a periodic report covering the given month.
+func NewMonthlyReport(year int, month time.Month, reportType PeriodicReportType) *PeriodicReport {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return &PeriodicReport{
+		Type:     reportType,
+		DateFrom: first.Format(reportDateLayout),
+		DateTo:   first.AddDate(0, 1, -1).Format(reportDateLayout),
+		Monthly:  true,
+	}
+}
+
+func (p *PeriodicReport) Validate() error {
+	if p.Type != PeriodicReportFull && p.Type != PeriodicReportSummary {
+		return fmt.Errorf("type must be one of: full, summary")
+	}
+	from, err := time.Parse(reportDateLayout, p.DateFrom)
+	if err != nil {
+		return fmt.Errorf("date_from must be in format YYYY-MM-DD")
+	}
+	to, err := time.Parse(reportDateLayout, p.DateTo)
+	if err != nil {
+		return fmt.Errorf("date_to must be in format YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return fmt.Errorf("date_to must not be before date_from")
+	}
+	if p.Monthly && (from.Day() != 1 || !to.Equal(from.AddDate(0, 1, -1))) {
+		return fmt.Errorf("monthly report must cover exactly one calendar month")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package novitus_gosdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewMonthlyReport(t *testing.T) {
+	tests := []struct {
+		year     int
+		month    time.Month
+		from, to string
+	}{
+		{2025, time.January, "2025-01-01", "2025-01-31"},
+		{2024, time.February, "2024-02-01", "2024-02-29"},
+		{2025, time.February, "2025-02-01", "2025-02-28"},
+		{2025, time.December, "2025-12-01", "2025-12-31"},
+	}
+	for _, tt := range tests {
+		report := NewMonthlyReport(tt.year, tt.month, PeriodicReportSummary)
+		if report.DateFrom != tt.from || report.DateTo != tt.to || !report.Monthly {
+			t.Errorf("NewMonthlyReport(%d, %s) = %+v", tt.year, tt.month, report)
+		}
+		if err := report.Validate(); err != nil {
+			t.Errorf("NewMonthlyReport(%d, %s): %v", tt.year, tt.month, err)
+		}
+	}
+}
+
+func TestPeriodicReportValidate(t *testing.T) {
+	tests := []struct {
+		report PeriodicReport
+		valid  bool
+	}{
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-05", DateTo: "2025-01-20"}, true},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-05", DateTo: "2025-01-05"}, true},
+		{PeriodicReport{Type: "daily", DateFrom: "2025-01-05", DateTo: "2025-01-20"}, false},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "05.01.2025", DateTo: "2025-01-20"}, false},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-05", DateTo: ""}, false},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-20", DateTo: "2025-01-05"}, false},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-01", DateTo: "2025-01-31", Monthly: true}, true},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-01", DateTo: "2025-01-30", Monthly: true}, false},
+		{PeriodicReport{Type: PeriodicReportFull, DateFrom: "2025-01-02", DateTo: "2025-02-01", Monthly: true}, false},
+	}
+	for _, tt := range tests {
+		err := tt.report.Validate()
+		if (err == nil) != tt.valid {
+			t.Errorf("%+v: Validate() = %v, want valid %v", tt.report, err, tt.valid)
+		}
+	}
+}
+
+func TestDailyReportValidate(t *testing.T) {
+	for date, valid := range map[string]bool{"": true, "2025-01-31": true, "2025-02-30": false, "31.01.2025": false} {
+		if err := (&DailyReport{Date: date}).Validate(); (err == nil) != valid {
+			t.Errorf("date %q: Validate() = %v, want valid %v", date, err, valid)
+		}
+	}
+}
@@ -1,19 +1,24 @@
 package novitus_gosdk
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/shopspring/decimal"
 )
 
+// ErrValidation is wrapped by the errors of documents that failed
+// validation before sending.
+var ErrValidation = errors.New("Validation Error")
+
 type Document interface {
 	Validate() error
 }
 
 type DiscountMarkup struct {
-	Type  string `json:"type"` //Enum: "percent_discount" "percent_markup" "value_discount" "value_markup"
-	Name  string `json:"name,omitempty"`
-	Value string `json:"value"`
+	Type  DiscountMarkupType `json:"type"` //Enum: "percent_discount" "percent_markup" "value_discount" "value_markup"
+	Name  string             `json:"name,omitempty"`
+	Value string             `json:"value"`
 }
 
 type Summary struct {
@@ -24,18 +29,28 @@ type Summary struct {
 }
 
 type EDocument struct {
-	TransactionId string `json:"transaction_id,omitempty"`
-	Protocol      string `json:"protocol,omitempty"`
-	PrintSendMode string `json:"print_send_mode,omitempty"`
+	TransactionId string            `json:"transaction_id,omitempty"`
+	Protocol      EDocumentProtocol `json:"protocol,omitempty"`        // Enum: "json" "xml"
+	PrintSendMode PrintSendMode     `json:"print_send_mode,omitempty"` // Enum: "print" "send" "print_and_send"
+}
+
+func (e *EDocument) Validate() error {
+	if e.Protocol != "" && e.Protocol != EDocumentProtocolJSON && e.Protocol != EDocumentProtocolXML {
+		return fmt.Errorf("protocol must be one of: json, xml")
+	}
+	if e.PrintSendMode != "" && e.PrintSendMode != PrintOnly && e.PrintSendMode != SendOnly && e.PrintSendMode != PrintAndSend {
+		return fmt.Errorf("print_send_mode must be one of: print, send, print_and_send")
+	}
+	return nil
 }
 
 type Buyer struct {
-	Name      string   `json:"name,omitempty"`
-	IdType    string   `json:"id_type,omitempty"`
-	Id        string   `json:"id,omitempty"`
-	LabelType string   `json:"label_type,omitempty"`
-	Address   []string `json:"address,omitempty"`
-	Nip       string   `json:"nip,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	IdType    IdType    `json:"id_type,omitempty"` // Enum: "nip" "regon" "pesel" "vat_ue" "other"
+	Id        string    `json:"id,omitempty"`
+	LabelType LabelType `json:"label_type,omitempty"` // Enum: "nip" "regon" "pesel" "vat_ue" "other"
+	Address   []string  `json:"address,omitempty"`
+	Nip       string    `json:"nip,omitempty"`
 	EDocument `json:"e_document,omitempty"`
 }
 
@@ -55,7 +70,7 @@ type Receipt struct {
 	Items         []interface{}              `json:"items,omitempty"` // Required: true
 	Payments      []interface{}              `json:"payments,omitempty"`
 	Summary       `json:"summary,omitempty"` // Required: true
-	PrintoutLines []interface{}              `json:"printout_lines,omitempty"`
+	PrintoutLines PrintoutLines              `json:"printout_lines,omitempty"`
 	Buyer         *Buyer                     `json:"buyer,omitempty"`
 	SystemInfo    *SystemInfo                `json:"system_info,omitempty"`
 	DeviceControl *DeviceControl             `json:"device_control,omitempty"`
@@ -65,9 +80,25 @@ func (r *Receipt) Validate() error {
 	if len(r.Items) == 0 {
 		return fmt.Errorf("items are required")
 	}
+	if err := validateItems(r.Items); err != nil {
+		return err
+	}
 	if r.Summary.Total == "" {
 		return fmt.Errorf("summary.total is required")
 	}
+	if r.Summary.DiscountMarkup != nil {
+		if err := r.Summary.DiscountMarkup.Validate(); err != nil {
+			return fmt.Errorf("summary.discount_markup: %w", err)
+		}
+	}
+	if err := r.PrintoutLines.Validate(); err != nil {
+		return fmt.Errorf("printout_lines: %w", err)
+	}
+	if r.Buyer != nil {
+		if err := r.Buyer.Validate(); err != nil {
+			return fmt.Errorf("buyer: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -129,7 +160,7 @@ type Invoice struct {
 	Items          []interface{}    `json:"items"` // Required: true
 	Payments       []interface{}    `json:"payments,omitempty"`
 	Summary        `json:"summary"` // Required: true
-	PrintoutLines  []interface{}    `json:"printout_lines,omitempty"`
+	PrintoutLines  PrintoutLines    `json:"printout_lines,omitempty"`
 	AdditionalInfo []AdditionalInfo `json:"additional_info,omitempty"`
 	DeviceControl  `json:"device_control,omitempty"`
 	SystemInfo     `json:"system_info,omitempty"`
@@ -142,12 +173,26 @@ func (i *Invoice) Validate() error {
 	if len(i.Items) == 0 {
 		return fmt.Errorf("items are required")
 	}
+	if err := validateItems(i.Items); err != nil {
+		return err
+	}
 	if i.Summary.Total == "" {
 		return fmt.Errorf("summary.total is required")
 	}
 	if i.Buyer.Name == "" && i.Buyer.Nip == "" {
 		return fmt.Errorf("buyer.name or buyer.nip is required")
 	}
+	if i.Summary.DiscountMarkup != nil {
+		if err := i.Summary.DiscountMarkup.Validate(); err != nil {
+			return fmt.Errorf("summary.discount_markup: %w", err)
+		}
+	}
+	if err := i.PrintoutLines.Validate(); err != nil {
+		return fmt.Errorf("printout_lines: %w", err)
+	}
+	if err := i.Buyer.Validate(); err != nil {
+		return fmt.Errorf("buyer: %w", err)
+	}
 	return nil
 }
 
@@ -160,7 +205,7 @@ type PrintoutOptions struct {
 
 type Printout struct {
 	Options        *PrintoutOptions `json:"options,omitempty"`
-	Lines          []interface{}    `json:"lines"` // Required: true
+	Lines          PrintoutLines    `json:"lines"` // Required: true
 	*EDocument     `json:"e_document,omitempty"`
 	*SystemInfo    `json:"system_info,omitempty"`
 	*DeviceControl `json:"device_control,omitempty"`
@@ -170,6 +215,14 @@ func (p *Printout) Validate() error {
 	if len(p.Lines) == 0 {
 		return fmt.Errorf("lines are required")
 	}
+	if err := p.Lines.Validate(); err != nil {
+		return fmt.Errorf("lines: %w", err)
+	}
+	if p.EDocument != nil {
+		if err := p.EDocument.Validate(); err != nil {
+			return fmt.Errorf("e_document: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -218,8 +271,14 @@ func (a *Article) Validate() error {
 	if err != nil {
 		return fmt.Errorf("invalid quantity format: %w", err)
 	}
-	if decValue != decPrice.Mul(decQuantity) {
-		return fmt.Errorf("value must be equal to price multiplied by quantity")
+	// Weighed goods have values rounded to grosze, e.g. 0.456 kg at 12.99 is 5.92.
+	if !decValue.Equal(decPrice.Mul(decQuantity).Round(2)) {
+		return fmt.Errorf("value must be equal to price multiplied by quantity, rounded to 2 decimal places")
+	}
+	if a.DiscountMarkup != nil {
+		if err := a.DiscountMarkup.ValidateFor(decValue); err != nil {
+			return fmt.Errorf("discount_markup: %w", err)
+		}
 	}
 	return nil
 }
@@ -360,7 +419,7 @@ type TextLine struct {
 	Bold       bool   `json:"bold,omitempty"`        // true if the text should be bold, false otherwise
 	Invers     bool   `json:"invers,omitempty"`      // true if the text should be inverted, false otherwise
 	Center     bool   `json:"center,omitempty"`      // true if the text should be centered, false otherwise
-	FontNumber int    `json:"font_number,omitempty"` // Font number, e.g. 1, 2, 3
+	FontNumber int    `json:"font_number,omitempty"` // Font number, 1, 2 or 3, 0 for the default font
 	Big        bool   `json:"big,omitempty"`         // true if the text should be big, false otherwise
 	Height     int    `json:"height,omitempty"`      // Height of the text in points, e.g. 12
 	Width      int    `json:"width,omitempty"`       // Width of the text in points, e.g. 100
@@ -378,8 +437,86 @@ func (tl *TextLine) Validate() error {
 	if tl.Width < 0 {
 		return fmt.Errorf("width must be a positive integer")
 	}
-	if tl.FontNumber < 1 || tl.FontNumber > 3 {
-		return fmt.Errorf("font_number must be between 1 and 3")
+	if tl.FontNumber < 0 || tl.FontNumber > 3 {
+		return fmt.Errorf("font_number must be between 1 and 3, or 0 for the default font")
+	}
+	return nil
+}
+
+type Separator struct {
+	Char string `json:"char,omitempty"` // Character the separator is drawn with, e.g. "-" Optional, printer default if empty
+}
+
+func (s *Separator) Validate() error {
+	if len([]rune(s.Char)) > 1 {
+		return fmt.Errorf("char must be a single character")
+	}
+	return nil
+}
+
+type Image struct {
+	Number int  `json:"number"`           // Number of the graphic stored in the printer, e.g. 1 Required: true
+	Center bool `json:"center,omitempty"` // true if the image should be centered, false otherwise
+}
+
+func (i *Image) Validate() error {
+	if i.Number < 1 {
+		return fmt.Errorf("number must be a positive integer")
+	}
+	return nil
+}
+
+type Barcode struct {
+	Type   BarcodeType `json:"type"`             // Enum: "ean8" "ean13" "code128" Required: true
+	Code   string      `json:"code"`             // Data encoded in the barcode, e.g. "5901234123457" Required: true
+	Height int         `json:"height,omitempty"` // Height of the bars in dots, e.g. 80
+	Width  int         `json:"width,omitempty"`  // Width of the narrowest bar in dots, 1 - 6
+	HRI    HRIPosition `json:"hri,omitempty"`    // Enum: "none" "above" "below" "both", placement of the human readable text
+}
+
+func (b *Barcode) Validate() error {
+	if b.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+	if err := b.Type.validateCode(b.Code); err != nil {
+		return err
+	}
+	if b.Height < 0 || b.Height > 255 {
+		return fmt.Errorf("height must be between 1 and 255, or 0 for the printer default")
+	}
+	if b.Width < 0 || b.Width > 6 {
+		return fmt.Errorf("width must be between 1 and 6, or 0 for the printer default")
+	}
+	if b.HRI != "" && b.HRI != HRINone && b.HRI != HRIAbove && b.HRI != HRIBelow && b.HRI != HRIBoth {
+		return fmt.Errorf("hri must be one of: none, above, below, both")
+	}
+	return nil
+}
+
+type QRCode struct {
+	Code            string            `json:"code"`                       // Data encoded in the QR code, e.g. "https://example.com" Required: true
+	Size            int               `json:"size,omitempty"`             // Size of a single module in dots, 1 - 16
+	ErrorCorrection QRErrorCorrection `json:"error_correction,omitempty"` // Enum: "L" "M" "Q" "H"
+	HRI             bool              `json:"hri,omitempty"`              // true if the encoded data should be printed below the code
+}
+
+func (q *QRCode) Validate() error {
+	if q.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+	if q.Size < 0 || q.Size > 16 {
+		return fmt.Errorf("size must be between 1 and 16, or 0 for the printer default")
+	}
+	level := q.ErrorCorrection
+	if level == "" {
+		level = QRLevelM
+	}
+	capacity, ok := qrCapacity[level]
+	if !ok {
+		return fmt.Errorf("error_correction must be one of: L, M, Q, H")
+	}
+	if len(q.Code) > capacity {
+		return fmt.Errorf("code must be at most %d bytes long for error correction level %s", capacity, level)
 	}
 	return nil
 }
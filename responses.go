@@ -6,14 +6,27 @@ type Error struct {
 	Errors      []string `json:"errors"`
 }
 
+const (
+	RequestStatusStored    = "STORED"    // Sent, waiting for confirmation
+	RequestStatusConfirmed = "CONFIRMED" // Confirmed, waiting in the queue
+	RequestStatusPending   = "PENDING"   // Being printed
+	RequestStatusDone      = "DONE"
+	RequestStatusError     = "ERROR"
+)
+
 type Request struct {
 	Status    string `json:"status"`
 	Id        string `json:"id"`
 	EDocument string `json:"e_document"`
-	JPKID     int    `json:"jpkid"`
+	JPKID     JPKID  `json:"jpkid"`
 	Error     `json:"error"`
 }
 
+// IsFinished reports whether the request reached a terminal status.
+func (r Request) IsFinished() bool {
+	return r.Status == RequestStatusDone || r.Status == RequestStatusError
+}
+
 type Device struct {
 	Status string `json:"status"`
 	Error  `json:"error"`
@@ -52,3 +65,18 @@ type DeleteDocumentResponse struct {
 type ErrorResponse struct {
 	Exception Error `json:"exception"`
 }
+
+// StatusError is an error answer of the Novitus host. Its message is the
+// description reported by the API.
+type StatusError struct {
+	StatusCode int
+	Exception  Error
+}
+
+func (e *StatusError) Error() string {
+	return e.Exception.Description
+}
+
+type JPKResponse struct {
+	JPK JPKRecord `json:"jpk"`
+}
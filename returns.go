@@ -0,0 +1,153 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ReturnReason string
+
+const (
+	ReturnReasonWithdrawal ReturnReason = "withdrawal" // Consumer withdrawal from the sale, e.g. within 14 days
+	ReturnReasonComplaint  ReturnReason = "complaint"  // Accepted complaint (reklamacja)
+	ReturnReasonDamaged    ReturnReason = "damaged"    // Goods damaged or defective
+	ReturnReasonMistake    ReturnReason = "mistake"    // Cashier mistake on the original receipt
+	ReturnReasonOther      ReturnReason = "other"      // ReasonDescription is required
+)
+
+// ReturnDocument is a return of goods sold on an earlier receipt. Items
+// are the returned articles (and returned containers) in the same form as
+// Receipt.Items, with positive values; Payments describe how the money
+// is refunded. Experimental: the "return" object type is not part of the
+// API description, so the fields may change or be rejected by some
+// printers.
+type ReturnDocument struct {
+	OriginalReceiptNumber string                     `json:"original_receipt_number"`          // Number of the receipt the goods were sold on Required: true
+	OriginalReceiptDate   string                     `json:"original_receipt_date"`            // e.g. "2025-01-31" Required: true
+	OriginalUniqueNumber  string                     `json:"original_unique_number,omitempty"` // Unique number of the printer that printed the receipt
+	Reason                ReturnReason               `json:"reason"`                           // Enum: "withdrawal" "complaint" "damaged" "mistake" "other" Required: true
+	ReasonDescription     string                     `json:"reason_description,omitempty"`     // Required if Reason is "other"
+	Items                 []interface{}              `json:"items,omitempty"`                  // Required: true
+	Payments              []interface{}              `json:"payments,omitempty"`
+	Summary               `json:"summary,omitempty"` // Required: true
+	PrintoutLines         PrintoutLines              `json:"printout_lines,omitempty"`
+	Buyer                 *Buyer                     `json:"buyer,omitempty"`
+	SystemInfo            *SystemInfo                `json:"system_info,omitempty"`
+	DeviceControl         *DeviceControl             `json:"device_control,omitempty"`
+}
+
+func (r *ReturnDocument) Validate() error {
+	if r.OriginalReceiptNumber == "" {
+		return fmt.Errorf("original_receipt_number is required")
+	}
+	date, err := time.ParseInLocation(reportDateLayout, r.OriginalReceiptDate, time.Local)
+	if err != nil {
+		return fmt.Errorf("original_receipt_date must be in format YYYY-MM-DD")
+	}
+	year, month, day := time.Now().Date()
+	if date.After(time.Date(year, month, day, 0, 0, 0, 0, time.Local)) {
+		return fmt.Errorf("original_receipt_date must not be in the future")
+	}
+	if r.Reason != ReturnReasonWithdrawal && r.Reason != ReturnReasonComplaint && r.Reason != ReturnReasonDamaged && r.Reason != ReturnReasonMistake && r.Reason != ReturnReasonOther {
+		return fmt.Errorf("reason must be one of: withdrawal, complaint, damaged, mistake, other")
+	}
+	if r.Reason == ReturnReasonOther && r.ReasonDescription == "" {
+		return fmt.Errorf("reason_description is required when reason is other")
+	}
+	if len(r.Items) == 0 {
+		return fmt.Errorf("items are required")
+	}
+	for idx, item := range r.Items {
+		decoded, err := decodeItem(item)
+		if err != nil {
+			return fmt.Errorf("item %d: %w", idx, err)
+		}
+		switch it := decoded.(type) {
+		case *Article:
+			err = it.Validate()
+		case *ContainerReturn:
+			err = it.Validate()
+		default:
+			return fmt.Errorf("item %d: only articles and container returns can be returned", idx)
+		}
+		if err != nil {
+			return fmt.Errorf("item %d: %w", idx, err)
+		}
+	}
+	if r.Summary.Total == "" {
+		return fmt.Errorf("summary.total is required")
+	}
+	total, err := decimal.NewFromString(r.Summary.Total)
+	if err != nil {
+		return fmt.Errorf("summary.total: invalid value format: %w", err)
+	}
+	if !total.IsPositive() {
+		return fmt.Errorf("summary.total must be greater than 0")
+	}
+	if r.Summary.DiscountMarkup != nil {
+		if err := r.Summary.DiscountMarkup.Validate(); err != nil {
+			return fmt.Errorf("summary.discount_markup: %w", err)
+		}
+	}
+	for idx, payment := range r.Payments {
+		if _, err := decodePayment(payment); err != nil {
+			return fmt.Errorf("payment %d: %w", idx, err)
+		}
+	}
+	if err := r.PrintoutLines.Validate(); err != nil {
+		return fmt.Errorf("printout_lines: %w", err)
+	}
+	if r.Buyer != nil {
+		if err := r.Buyer.Validate(); err != nil {
+			return fmt.Errorf("buyer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ReturnDocument) TaxBreakdown(rates PTURates) (TaxBreakdown, error) {
+	return computeTaxBreakdown(r.Items, r.Summary, rates)
+}
+
+// NewFullReturn prepares the return of every article and container of
+// receipt. The refund is paid in cash unless Payments are changed.
+func NewFullReturn(receipt *Receipt, receiptNumber, receiptDate string, reason ReturnReason) (*ReturnDocument, error) {
+	ret := &ReturnDocument{
+		OriginalReceiptNumber: receiptNumber,
+		OriginalReceiptDate:   receiptDate,
+		Reason:                reason,
+		Buyer:                 receipt.Buyer,
+		SystemInfo:            receipt.SystemInfo,
+		Summary:               Summary{DiscountMarkup: receipt.Summary.DiscountMarkup, Total: receipt.Summary.Total},
+	}
+	for idx, item := range receipt.Items {
+		decoded, err := decodeItem(item)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", idx, err)
+		}
+		switch it := decoded.(type) {
+		case *Article:
+			ret.Items = append(ret.Items, map[string]interface{}{"article": *it})
+		case *Container:
+			ret.Items = append(ret.Items, map[string]interface{}{"container_return": ContainerReturn(*it)})
+		default:
+			return nil, fmt.Errorf("item %d: %s items cannot be returned", idx, itemKind(decoded))
+		}
+	}
+	ret.Payments = []interface{}{map[string]interface{}{"cash": Cash{Value: receipt.Summary.Total}}}
+	return ret, nil
+}
+
+func (n *NovitusClient) SendReturn(ret *ReturnDocument, confirm bool) (CheckDocumentStatusResponse, error) {
+	return n.sendAndCheck("return", "return", ret, confirm)
+}
+
+func (n *NovitusClient) SendReturnPending(ret *ReturnDocument, timeout time.Duration) (*PendingDocument, error) {
+	pending, err := n.SendPending("return", ret, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send return: %w", err)
+	}
+	return pending, nil
+}
@@ -0,0 +1,115 @@
+package novitus_gosdk
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleReturn() ReturnDocument {
+	return ReturnDocument{
+		OriginalReceiptNumber: "123/2025",
+		OriginalReceiptDate:   "2025-01-31",
+		Reason:                ReturnReasonWithdrawal,
+		Items:                 []interface{}{article("A", "10.00")},
+		Summary:               Summary{Total: "10.00"},
+	}
+}
+
+func TestReturnDocumentValidate(t *testing.T) {
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(reportDateLayout)
+	tests := []struct {
+		name    string
+		change  func(r *ReturnDocument)
+		wantErr string
+	}{
+		{"valid", func(r *ReturnDocument) {}, ""},
+		{"no receipt number", func(r *ReturnDocument) { r.OriginalReceiptNumber = "" }, "original_receipt_number"},
+		{"bad date", func(r *ReturnDocument) { r.OriginalReceiptDate = "31.01.2025" }, "format YYYY-MM-DD"},
+		{"future date", func(r *ReturnDocument) { r.OriginalReceiptDate = tomorrow }, "in the future"},
+		{"unknown reason", func(r *ReturnDocument) { r.Reason = "bored" }, "reason must be one of"},
+		{"other without description", func(r *ReturnDocument) { r.Reason = ReturnReasonOther }, "reason_description"},
+		{"other with description", func(r *ReturnDocument) { r.Reason, r.ReasonDescription = ReturnReasonOther, "wrong size" }, ""},
+		{"no items", func(r *ReturnDocument) { r.Items = nil }, "items are required"},
+		{"advance", func(r *ReturnDocument) {
+			r.Items = []interface{}{map[string]interface{}{"advance": Advance{Description: "a", PTU: "A", Value: "1.00"}}}
+		}, "only articles and container returns"},
+		{"container return", func(r *ReturnDocument) {
+			r.Items = append(r.Items, map[string]interface{}{"container_return": ContainerReturn{Value: "0.50"}})
+		}, ""},
+		{"no total", func(r *ReturnDocument) { r.Summary.Total = "" }, "summary.total is required"},
+		{"zero total", func(r *ReturnDocument) { r.Summary.Total = "0" }, "greater than 0"},
+		{"unknown payment", func(r *ReturnDocument) { r.Payments = []interface{}{42} }, "payment 0"},
+		{"buyer", func(r *ReturnDocument) { r.Buyer = &Buyer{Nip: "5260250275"} }, "buyer: nip"},
+	}
+	for _, tt := range tests {
+		ret := sampleReturn()
+		tt.change(&ret)
+		err := ret.Validate()
+		if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s: Validate() = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestReturnDocumentToday(t *testing.T) {
+	// A zone where the local date is already a day ahead of UTC, so the
+	// local midnight of today is still in the future in UTC.
+	now := time.Now().UTC()
+	offset := now.Truncate(24 * time.Hour).Add(25 * time.Hour).Sub(now)
+	local := time.Local
+	time.Local = time.FixedZone("ahead", int(offset.Seconds()))
+	t.Cleanup(func() { time.Local = local })
+	ret := sampleReturn()
+	ret.OriginalReceiptDate = time.Now().Format(reportDateLayout)
+	if err := ret.Validate(); err != nil {
+		t.Errorf("receipt from today: Validate() = %v", err)
+	}
+}
+
+func TestNewFullReturn(t *testing.T) {
+	receipt := &Receipt{
+		Items: []interface{}{
+			article("A", "10.00"),
+			map[string]interface{}{"container": Container{Name: "Skrzynka", Value: "2.00"}},
+		},
+		Summary:    Summary{Total: "12.00"},
+		SystemInfo: &SystemInfo{CashierName: "Anna"},
+	}
+	ret, err := NewFullReturn(receipt, "1/2025", "2025-01-31", ReturnReasonComplaint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ret.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ret.Items) != 2 || ret.SystemInfo != receipt.SystemInfo || ret.Summary.Total != "12.00" {
+		t.Errorf("got %+v", ret)
+	}
+	if _, ok := ret.Items[1].(map[string]interface{})["container_return"].(ContainerReturn); !ok {
+		t.Errorf("container was returned as %v", ret.Items[1])
+	}
+	if cash, ok := ret.Payments[0].(map[string]interface{})["cash"].(Cash); !ok || cash.Value != "12.00" {
+		t.Errorf("payments = %v", ret.Payments)
+	}
+	breakdown, err := ret.TaxBreakdown(DefaultPTURates)
+	if err != nil || breakdown.Gross.String() != "10" {
+		t.Errorf("TaxBreakdown() = %+v, %v", breakdown, err)
+	}
+
+	receipt.Items = append(receipt.Items, map[string]interface{}{"advance": Advance{Description: "a", PTU: "A", Value: "1.00"}})
+	if _, err := NewFullReturn(receipt, "1/2025", "2025-01-31", ReturnReasonComplaint); err == nil {
+		t.Error("expected an error for an advance")
+	}
+}
+
+func TestSendReturn(t *testing.T) {
+	client, f := newFakeClient(t)
+	ret := sampleReturn()
+	if _, err := client.SendReturn(&ret, true); err != nil {
+		t.Fatal(err)
+	}
+	if f.count("POST /api/v1/return") != 1 || f.count("PUT /api/v1/return/req1") != 1 {
+		t.Errorf("calls = %v", f.Calls())
+	}
+}
@@ -0,0 +1,134 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// PTURates maps a PTU letter ("A" - "G") to its VAT rate in percent, as
+// programmed in the fiscal printer. Tax exempt groups use a zero rate.
+type PTURates map[string]decimal.Decimal
+
+// DefaultPTURates is the rate table most Polish fiscal printers are
+// programmed with. E is the tax exempt group and F and G are spare
+// groups, all without VAT. Check the printer configuration before relying
+// on it.
+var DefaultPTURates = PTURates{
+	"A": decimal.NewFromInt(23),
+	"B": decimal.NewFromInt(8),
+	"C": decimal.NewFromInt(5),
+	"D": decimal.Zero,
+	"E": decimal.Zero,
+	"F": decimal.Zero,
+	"G": decimal.Zero,
+}
+
+type TaxGroup struct {
+	PTU   string          `json:"ptu"`
+	Rate  decimal.Decimal `json:"rate"`
+	Gross decimal.Decimal `json:"gross"`
+	Net   decimal.Decimal `json:"net"`
+	VAT   decimal.Decimal `json:"vat"`
+}
+
+type TaxBreakdown struct {
+	Groups []TaxGroup      `json:"groups"` // Sorted by PTU letter
+	Gross  decimal.Decimal `json:"gross"`
+	Net    decimal.Decimal `json:"net"`
+	VAT    decimal.Decimal `json:"vat"`
+}
+
+// Group returns the tax group for the given PTU letter, if present.
+func (t TaxBreakdown) Group(ptu string) (TaxGroup, bool) {
+	for _, group := range t.Groups {
+		if group.PTU == ptu {
+			return group, true
+		}
+	}
+	return TaxGroup{}, false
+}
+
+func (r *Receipt) TaxBreakdown(rates PTURates) (TaxBreakdown, error) {
+	return computeTaxBreakdown(r.Items, r.Summary, rates)
+}
+
+func (i *Invoice) TaxBreakdown(rates PTURates) (TaxBreakdown, error) {
+	return computeTaxBreakdown(i.Items, i.Summary, rates)
+}
+
+// computeTaxBreakdown totals the document the same way the printer does:
+// item discounts/markups are applied to the item value, the summary
+// discount/markup is split between PTU groups in proportion to their
+// gross value, and VAT is calculated once per PTU group from its gross
+// total, rounded half up to grosze.
+func computeTaxBreakdown(items []interface{}, summary Summary, rates PTURates) (TaxBreakdown, error) {
+	gross := make(map[string]decimal.Decimal)
+	for idx, item := range items {
+		decoded, err := decodeItem(item)
+		if err != nil {
+			return TaxBreakdown{}, fmt.Errorf("item %d: %w", idx, err)
+		}
+		var ptu, value string
+		sign := decimal.NewFromInt(1)
+		var discountMarkup *DiscountMarkup
+		switch it := decoded.(type) {
+		case *Article:
+			ptu, value, discountMarkup = it.PTU, it.Value, it.DiscountMarkup
+		case *Advance:
+			ptu, value = it.PTU, it.Value
+		case *AdvanceReturn:
+			ptu, value = it.PTU, it.Value
+			sign = sign.Neg()
+		default:
+			// Containers are deposits and are not subject to VAT.
+			continue
+		}
+		decValue, err := decimal.NewFromString(value)
+		if err != nil {
+			return TaxBreakdown{}, fmt.Errorf("item %d: invalid value format: %w", idx, err)
+		}
+		if discountMarkup != nil {
+			adjustment, err := discountMarkup.Amount(decValue)
+			if err != nil {
+				return TaxBreakdown{}, fmt.Errorf("item %d: %w", idx, err)
+			}
+			decValue = decValue.Add(adjustment)
+		}
+		gross[ptu] = gross[ptu].Add(decValue.Mul(sign))
+	}
+
+	if summary.DiscountMarkup != nil {
+		distributed, err := DistributeDiscountMarkup(summary.DiscountMarkup, gross)
+		if err != nil {
+			return TaxBreakdown{}, fmt.Errorf("summary: %w", err)
+		}
+		gross = distributed
+	}
+
+	var breakdown TaxBreakdown
+	for _, ptu := range sortedPTUs(gross) {
+		rate, ok := rates[ptu]
+		if !ok {
+			return TaxBreakdown{}, fmt.Errorf("no rate defined for ptu %s", ptu)
+		}
+		group := TaxGroup{PTU: ptu, Rate: rate, Gross: gross[ptu]}
+		group.VAT = group.Gross.Mul(rate).Div(rate.Add(decimal.NewFromInt(100))).Round(2)
+		group.Net = group.Gross.Sub(group.VAT)
+		breakdown.Groups = append(breakdown.Groups, group)
+		breakdown.Gross = breakdown.Gross.Add(group.Gross)
+		breakdown.Net = breakdown.Net.Add(group.Net)
+		breakdown.VAT = breakdown.VAT.Add(group.VAT)
+	}
+	return breakdown, nil
+}
+
+func sortedPTUs(groups map[string]decimal.Decimal) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,139 @@
+package novitus_gosdk
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func article(ptu, value string) map[string]interface{} {
+	return map[string]interface{}{"article": Article{Name: "item", PTU: ptu, Quantity: "1", Price: value, Value: value}}
+}
+
+type wantGroup struct {
+	ptu, gross, net, vat string
+}
+
+func TestTaxBreakdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []interface{}
+		summary Summary
+		want    []wantGroup
+		vat     string
+	}{
+		{
+			name:  "single article",
+			items: []interface{}{article("A", "123.00")},
+			want:  []wantGroup{{"A", "123.00", "100.00", "23.00"}},
+			vat:   "23.00",
+		},
+		{
+			// VAT is rounded once per group: 0.19 per article would give 0.38.
+			name:  "vat rounded per group",
+			items: []interface{}{article("A", "1.00"), article("A", "1.00")},
+			want:  []wantGroup{{"A", "2.00", "1.63", "0.37"}},
+			vat:   "0.37",
+		},
+		{
+			name:  "all rates",
+			items: []interface{}{article("B", "10.80"), article("C", "10.50"), article("A", "12.30"), article("D", "5.00"), article("E", "3.00"), article("G", "1.00")},
+			want: []wantGroup{
+				{"A", "12.30", "10.00", "2.30"},
+				{"B", "10.80", "10.00", "0.80"},
+				{"C", "10.50", "10.00", "0.50"},
+				{"D", "5.00", "5.00", "0.00"},
+				{"E", "3.00", "3.00", "0.00"},
+				{"G", "1.00", "1.00", "0.00"},
+			},
+			vat: "3.60",
+		},
+		{
+			name: "article discount",
+			items: []interface{}{map[string]interface{}{"article": Article{Name: "item", PTU: "A", Quantity: "1", Price: "100.00", Value: "100.00",
+				DiscountMarkup: &DiscountMarkup{Type: PercentDiscount, Value: "15"}}}},
+			want: []wantGroup{{"A", "85.00", "69.11", "15.89"}},
+			vat:  "15.89",
+		},
+		{
+			name:    "summary discount split proportionally",
+			items:   []interface{}{article("A", "60.00"), article("B", "40.00")},
+			summary: Summary{DiscountMarkup: &DiscountMarkup{Type: ValueDiscount, Value: "10.00"}},
+			want:    []wantGroup{{"A", "54.00", "43.90", "10.10"}, {"B", "36.00", "33.33", "2.67"}},
+			vat:     "12.77",
+		},
+		{
+			// 10% of 10.00 split as 0.33 + 0.33 + 0.33, the residue goes to C.
+			name:    "rounding residue to largest group",
+			items:   []interface{}{article("A", "3.33"), article("B", "3.33"), article("C", "3.34")},
+			summary: Summary{DiscountMarkup: &DiscountMarkup{Type: PercentDiscount, Value: "10"}},
+			want:    []wantGroup{{"A", "3.00", "2.44", "0.56"}, {"B", "3.00", "2.78", "0.22"}, {"C", "3.00", "2.86", "0.14"}},
+			vat:     "0.92",
+		},
+		{
+			name:    "summary markup",
+			items:   []interface{}{article("A", "50.00")},
+			summary: Summary{DiscountMarkup: &DiscountMarkup{Type: ValueMarkup, Value: "11.50"}},
+			want:    []wantGroup{{"A", "61.50", "50.00", "11.50"}},
+			vat:     "11.50",
+		},
+		{
+			name: "advance return and containers",
+			items: []interface{}{
+				article("A", "246.00"),
+				map[string]interface{}{"advance_return": AdvanceReturn{Description: "advance", PTU: "A", Value: "123.00"}},
+				map[string]interface{}{"container": Container{Value: "0.50"}},
+			},
+			want: []wantGroup{{"A", "123.00", "100.00", "23.00"}},
+			vat:  "23.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt := &Receipt{Items: tt.items, Summary: tt.summary}
+			breakdown, err := receipt.TaxBreakdown(DefaultPTURates)
+			if err != nil {
+				t.Fatalf("TaxBreakdown: %v", err)
+			}
+			if len(breakdown.Groups) != len(tt.want) {
+				t.Fatalf("got %d groups, want %d", len(breakdown.Groups), len(tt.want))
+			}
+			for idx, want := range tt.want {
+				group := breakdown.Groups[idx]
+				if group.PTU != want.ptu || group.Gross.StringFixed(2) != want.gross || group.Net.StringFixed(2) != want.net || group.VAT.StringFixed(2) != want.vat {
+					t.Errorf("group %d = %s %s/%s/%s, want %s %s/%s/%s", idx,
+						group.PTU, group.Gross.StringFixed(2), group.Net.StringFixed(2), group.VAT.StringFixed(2),
+						want.ptu, want.gross, want.net, want.vat)
+				}
+			}
+			if got := breakdown.VAT.StringFixed(2); got != tt.vat {
+				t.Errorf("VAT = %s, want %s", got, tt.vat)
+			}
+			if !breakdown.Gross.Equal(breakdown.Net.Add(breakdown.VAT)) {
+				t.Errorf("gross %s != net %s + vat %s", breakdown.Gross, breakdown.Net, breakdown.VAT)
+			}
+		})
+	}
+}
+
+func TestTaxBreakdownUnknownRate(t *testing.T) {
+	receipt := &Receipt{Items: []interface{}{article("B", "1.00")}}
+	_, err := receipt.TaxBreakdown(PTURates{"A": decimal.NewFromInt(23)})
+	if err == nil {
+		t.Fatal("expected an error for a PTU without a rate")
+	}
+}
+
+func TestInvoiceTaxBreakdown(t *testing.T) {
+	invoice := &Invoice{Items: []interface{}{article("B", "108.00")}}
+	breakdown, err := invoice.TaxBreakdown(DefaultPTURates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group, ok := breakdown.Group("B"); !ok || group.VAT.StringFixed(2) != "8.00" {
+		t.Errorf("group B = %+v, %v", group, ok)
+	}
+	if _, ok := breakdown.Group("A"); ok {
+		t.Error("unexpected group A")
+	}
+}
@@ -0,0 +1,215 @@
+package novitus_gosdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+type IdType string
+
+const (
+	IdTypeNip   IdType = "nip"
+	IdTypeRegon IdType = "regon"
+	IdTypePesel IdType = "pesel"
+	IdTypeVatUE IdType = "vat_ue" // EU VAT number with country prefix, e.g. "DE123456789"
+	IdTypeOther IdType = "other"  // Not validated, e.g. passport or foreign tax number
+)
+
+type LabelType string
+
+const (
+	LabelTypeNip   LabelType = "nip"
+	LabelTypeRegon LabelType = "regon"
+	LabelTypePesel LabelType = "pesel"
+	LabelTypeVatUE LabelType = "vat_ue"
+	LabelTypeOther LabelType = "other"
+)
+
+// euVatPrefixes are the country prefixes of EU VAT numbers (VIES). Greece
+// uses "EL" and Northern Ireland "XI" instead of their ISO codes.
+var euVatPrefixes = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CY": true, "CZ": true, "DE": true, "DK": true,
+	"EE": true, "EL": true, "ES": true, "FI": true, "FR": true, "HR": true, "HU": true,
+	"IE": true, "IT": true, "LT": true, "LU": true, "LV": true, "MT": true, "NL": true,
+	"PL": true, "PT": true, "RO": true, "SE": true, "SI": true, "SK": true, "XI": true,
+}
+
+// NormalizeTaxId strips spaces and dashes from a tax identifier and
+// upper-cases it, e.g. "pl 526-025-02-74" becomes "PL5260250274".
+func NormalizeTaxId(id string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(id)))
+}
+
+// NormalizeNIP normalizes a NIP and removes its "PL" prefix.
+func NormalizeNIP(nip string) string {
+	return strings.TrimPrefix(NormalizeTaxId(nip), "PL")
+}
+
+// SplitEUVatId splits an EU VAT number into its country prefix and
+// number. ok is false if the id does not start with an EU country prefix.
+func SplitEUVatId(id string) (country, number string, ok bool) {
+	id = NormalizeTaxId(id)
+	if len(id) < 3 || !euVatPrefixes[id[:2]] {
+		return "", "", false
+	}
+	return id[:2], id[2:], true
+}
+
+func ValidateNIP(nip string) error {
+	nip = NormalizeNIP(nip)
+	if err := checkDigits(nip, 10); err != nil {
+		return fmt.Errorf("nip %w", err)
+	}
+	if checksum(nip, []int{6, 5, 7, 2, 3, 4, 5, 6, 7})%11 != int(nip[9]-'0') {
+		return fmt.Errorf("nip has invalid checksum")
+	}
+	return nil
+}
+
+func ValidatePESEL(pesel string) error {
+	pesel = NormalizeTaxId(pesel)
+	if err := checkDigits(pesel, 11); err != nil {
+		return fmt.Errorf("pesel %w", err)
+	}
+	if (10-checksum(pesel, []int{1, 3, 7, 9, 1, 3, 7, 9, 1, 3})%10)%10 != int(pesel[10]-'0') {
+		return fmt.Errorf("pesel has invalid checksum")
+	}
+	return nil
+}
+
+// ValidateREGON accepts both 9 and 14 digit REGON numbers.
+func ValidateREGON(regon string) error {
+	regon = NormalizeTaxId(regon)
+	var weights []int
+	switch len(regon) {
+	case 9:
+		weights = []int{8, 9, 2, 3, 4, 5, 6, 7}
+	case 14:
+		weights = []int{2, 4, 8, 5, 0, 9, 7, 3, 6, 1, 2, 4, 8}
+	default:
+		return fmt.Errorf("regon must have 9 or 14 digits")
+	}
+	if err := checkDigits(regon, len(regon)); err != nil {
+		return fmt.Errorf("regon %w", err)
+	}
+	if checksum(regon, weights)%11%10 != int(regon[len(regon)-1]-'0') {
+		return fmt.Errorf("regon has invalid checksum")
+	}
+	return nil
+}
+
+// ValidateEUVatId validates an EU VAT number. Polish numbers are checked
+// as NIP, other countries only for their prefix and general format.
+func ValidateEUVatId(id string) error {
+	country, number, ok := SplitEUVatId(id)
+	if !ok {
+		return fmt.Errorf("vat id must start with an EU country prefix")
+	}
+	if country == "PL" {
+		return ValidateNIP(number)
+	}
+	if len(number) < 2 || len(number) > 13 {
+		return fmt.Errorf("vat id must have between 2 and 13 characters after the country prefix")
+	}
+	for _, r := range number {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') && r != '+' && r != '*' {
+			return fmt.Errorf("vat id may contain only letters and digits")
+		}
+	}
+	return nil
+}
+
+// ValidateTaxId validates id according to its type. Ids of type
+// IdTypeOther are only checked for being non-empty.
+func ValidateTaxId(idType IdType, id string) error {
+	switch idType {
+	case IdTypeNip:
+		return ValidateNIP(id)
+	case IdTypeRegon:
+		return ValidateREGON(id)
+	case IdTypePesel:
+		return ValidatePESEL(id)
+	case IdTypeVatUE:
+		return ValidateEUVatId(id)
+	case IdTypeOther:
+		if NormalizeTaxId(id) == "" {
+			return fmt.Errorf("id is required")
+		}
+		return nil
+	}
+	return fmt.Errorf("id_type must be one of: nip, regon, pesel, vat_ue, other")
+}
+
+// Normalize strips separators from Nip and Id and the "PL" prefix from
+// Polish NIP numbers.
+func (b *Buyer) Normalize() {
+	b.Nip = normalizeBuyerNip(b.Nip)
+	b.Id = normalizeBuyerId(b.IdType, b.Id)
+}
+
+func normalizeBuyerNip(nip string) string {
+	if country, _, ok := SplitEUVatId(nip); ok && country != "PL" {
+		return NormalizeTaxId(nip)
+	}
+	return NormalizeNIP(nip)
+}
+
+func normalizeBuyerId(idType IdType, id string) string {
+	switch idType {
+	case IdTypeNip:
+		return NormalizeNIP(id)
+	case IdTypeOther:
+		return strings.TrimSpace(id)
+	}
+	return NormalizeTaxId(id)
+}
+
+// Validate checks the ids as Normalize would write them, so ids with
+// separators or a "PL" prefix are valid; the buyer itself is not changed.
+func (b *Buyer) Validate() error {
+	normalized := *b
+	normalized.Normalize()
+	if normalized.Nip != "" {
+		if country, _, ok := SplitEUVatId(normalized.Nip); ok && country != "PL" {
+			if err := ValidateEUVatId(normalized.Nip); err != nil {
+				return fmt.Errorf("nip: %w", err)
+			}
+		} else if err := ValidateNIP(normalized.Nip); err != nil {
+			return fmt.Errorf("nip: %w", err)
+		}
+	}
+	if normalized.Id != "" || normalized.IdType != "" {
+		if err := ValidateTaxId(normalized.IdType, normalized.Id); err != nil {
+			return fmt.Errorf("id: %w", err)
+		}
+	}
+	if err := b.EDocument.Validate(); err != nil {
+		return fmt.Errorf("e_document: %w", err)
+	}
+	switch b.LabelType {
+	case "", LabelTypeNip, LabelTypeRegon, LabelTypePesel, LabelTypeVatUE, LabelTypeOther:
+	default:
+		return fmt.Errorf("label_type must be one of: nip, regon, pesel, vat_ue, other")
+	}
+	return nil
+}
+
+func checkDigits(id string, length int) error {
+	if len(id) != length {
+		return fmt.Errorf("must have %d digits", length)
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("may contain only digits")
+		}
+	}
+	return nil
+}
+
+func checksum(digits string, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+	return sum
+}
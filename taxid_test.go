@@ -0,0 +1,96 @@
+package novitus_gosdk
+
+import "testing"
+
+func TestValidateTaxId(t *testing.T) {
+	tests := []struct {
+		idType IdType
+		id     string
+		valid  bool
+	}{
+		{IdTypeNip, "5260250274", true},
+		{IdTypeNip, "PL 526-025-02-74", true},
+		{IdTypeNip, "5260250275", false},
+		{IdTypeNip, "526025027", false},
+		{IdTypeNip, "52602502A4", false},
+		{IdTypePesel, "44051401359", true},
+		{IdTypePesel, "44051401358", false},
+		{IdTypeRegon, "123456785", true},
+		{IdTypeRegon, "123456786", false},
+		{IdTypeRegon, "12345678512347", true},
+		{IdTypeRegon, "12345678512348", false},
+		{IdTypeRegon, "1234567", false},
+		{IdTypeVatUE, "DE123456789", true},
+		{IdTypeVatUE, "PL5260250274", true},
+		{IdTypeVatUE, "PL5260250275", false},
+		{IdTypeVatUE, "US123456789", false},
+		{IdTypeVatUE, "FR1", false},
+		{IdTypeVatUE, "NL123456789B01", true},
+		{IdTypeOther, "AB 123", true},
+		{IdTypeOther, " ", false},
+		{"passport", "AB123", false},
+	}
+	for _, tt := range tests {
+		err := ValidateTaxId(tt.idType, tt.id)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateTaxId(%q, %q) = %v, want valid %v", tt.idType, tt.id, err, tt.valid)
+		}
+	}
+}
+
+func TestBuyerNormalize(t *testing.T) {
+	tests := []struct {
+		buyer Buyer
+		want  Buyer
+	}{
+		{Buyer{Nip: "PL 526-025-02-74"}, Buyer{Nip: "5260250274"}},
+		{Buyer{Nip: "de 123 456 789"}, Buyer{Nip: "DE123456789"}},
+		{Buyer{IdType: IdTypeNip, Id: "PL526-025-02-74"}, Buyer{IdType: IdTypeNip, Id: "5260250274"}},
+		{Buyer{IdType: IdTypeVatUE, Id: "pl 5260250274"}, Buyer{IdType: IdTypeVatUE, Id: "PL5260250274"}},
+		{Buyer{IdType: IdTypeOther, Id: " AB 123 "}, Buyer{IdType: IdTypeOther, Id: "AB 123"}},
+	}
+	for _, tt := range tests {
+		buyer := tt.buyer
+		if err := buyer.Validate(); err != nil {
+			t.Errorf("%+v: Validate() before Normalize = %v", tt.buyer, err)
+		}
+		if buyer.Nip != tt.buyer.Nip || buyer.Id != tt.buyer.Id {
+			t.Errorf("Validate changed the buyer to %+v", buyer)
+		}
+		buyer.Normalize()
+		if buyer.Nip != tt.want.Nip || buyer.Id != tt.want.Id {
+			t.Errorf("Normalize(%+v) = %+v, want %+v", tt.buyer, buyer, tt.want)
+		}
+		if err := buyer.Validate(); err != nil {
+			t.Errorf("%+v: %v", buyer, err)
+		}
+	}
+}
+
+func TestBuyerValidate(t *testing.T) {
+	tests := []struct {
+		buyer Buyer
+		valid bool
+	}{
+		{Buyer{Name: "Jan"}, true},
+		{Buyer{Nip: "5260250274", LabelType: LabelTypeNip}, true},
+		{Buyer{Nip: "PL5260250274"}, true},
+		{Buyer{Nip: "526-025-02-74"}, true},
+		{Buyer{IdType: IdTypePesel, Id: "440514 01359"}, true},
+		{Buyer{Nip: "5260250274", LabelType: "passport"}, false},
+		{Buyer{Nip: "5260250275"}, false},
+		{Buyer{IdType: IdTypePesel}, false},
+		{Buyer{Id: "5260250274"}, false},
+	}
+	for _, tt := range tests {
+		err := tt.buyer.Validate()
+		if (err == nil) != tt.valid {
+			t.Errorf("%+v: Validate() = %v, want valid %v", tt.buyer, err, tt.valid)
+		}
+	}
+
+	receipt := &Receipt{Items: []interface{}{article("A", "1.00")}, Summary: Summary{Total: "1.00"}, Buyer: &Buyer{Nip: "526-025-02-75"}}
+	if err := receipt.Validate(); err == nil {
+		t.Error("expected the receipt to reject a buyer with an invalid nip")
+	}
+}